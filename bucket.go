@@ -0,0 +1,242 @@
+package dynamocity
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// DefaultBucketConcurrency is the maximum number of partition keys queried at once by QueryBuckets, when the
+// BucketQuery does not specify a Concurrency
+const DefaultBucketConcurrency = 8
+
+// DefaultMaxPartitionKeys is the maximum number of partition keys which PartitionKeys will enumerate, when the
+// BucketStrategy does not specify a MaxPartitionKeys
+const DefaultMaxPartitionKeys = 10000
+
+// BucketStrategy derives time bucketed partition keys, which spread a high volume of items with the fixed precision
+// supplied by P across many partitions.
+//
+// A partition key is a dynamocity.CompositeKey of the Prefix and the UTC prefix of the Timestamp at the Granularity,
+// followed by a hash shard when Shards is greater than 1. For example, `EVENTS#2020-04-01T14` or `EVENTS#2020-04-01T14#3`.
+type BucketStrategy[P Precision] struct {
+	// Prefix is the first segment of each partition key, such as an entity type
+	Prefix string
+	// Granularity is the calendar unit of each bucket
+	Granularity Granularity
+	// Shards is the number of hash shards of each bucket. Sharding is disabled if Shards is less than 2
+	Shards int
+	// MaxPartitionKeys is the maximum number of partition keys, being each bucket multiplied by its shards, which
+	// PartitionKeys will enumerate for an Interval, which defaults to DefaultMaxPartitionKeys
+	MaxPartitionKeys int
+}
+
+// PartitionKey returns the partition key of the bucket containing t. When sharded, the shard is chosen by hashing the
+// shardKey, such as the identifier of the item, so that the same shardKey is always in the same shard of a bucket
+func (s BucketStrategy[P]) PartitionKey(t Time[P], shardKey string) CompositeKey {
+	if s.Shards < 2 {
+		return s.partitionKey(t.Time(), -1)
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(shardKey))
+	return s.partitionKey(t.Time(), int(hash.Sum32()%uint32(s.Shards)))
+}
+
+// PartitionKeys returns the partition keys of every bucket and shard which may contain a dynamocity.Time within the
+// dynamocity.Interval, in chronological order of the buckets. An error is returned if the dynamocity.Interval does not
+// contain any representable value, the Granularity is unknown, or the dynamocity.Interval spans more than
+// MaxPartitionKeys, so that a long Interval at a fine Granularity fails before any key is queried
+func (s BucketStrategy[P]) PartitionKeys(i Interval[P]) ([]CompositeKey, error) {
+	if _, ok := granularityLengths[s.Granularity]; !ok {
+		return nil, fmt.Errorf("Bucket Granularity '%d' is unknown", s.Granularity)
+	}
+	lower, upper, ok := i.bounds()
+	if !ok {
+		return nil, fmt.Errorf("Interval '%s' does not contain any value with the precision of the sort key", i)
+	}
+	maxKeys := s.MaxPartitionKeys
+	if maxKeys <= 0 {
+		maxKeys = DefaultMaxPartitionKeys
+	}
+	var keys []CompositeKey
+	for bucket := s.Granularity.truncate(lower.Time()); !bucket.After(upper.Time()); bucket = s.Granularity.next(bucket) {
+		if len(keys)+max(s.Shards, 1) > maxKeys {
+			return nil, fmt.Errorf("Interval '%s' spans more than %d partition keys of the Bucket Strategy", i, maxKeys)
+		}
+		if s.Shards < 2 {
+			keys = append(keys, s.partitionKey(bucket, -1))
+			continue
+		}
+		for shard := 0; shard < s.Shards; shard++ {
+			keys = append(keys, s.partitionKey(bucket, shard))
+		}
+	}
+	return keys, nil
+}
+
+// partitionKey is a helper function to build the partition key of the bucket containing t, without a shard if it is negative
+func (s BucketStrategy[P]) partitionKey(t time.Time, shard int) CompositeKey {
+	key := CompositeKey{s.Prefix, prefix(t.UTC().Format(layout[P]()), s.Granularity)}
+	if shard >= 0 {
+		key = append(key, strconv.Itoa(shard))
+	}
+	return key
+}
+
+// BucketQuery describes a query of every bucket of a BucketStrategy within an Interval, where the sort key is a
+// dynamocity.Time with the fixed precision supplied by P
+type BucketQuery[P Precision] struct {
+	TableName        string
+	IndexName        string
+	PartitionKeyName string
+	SortKeyName      string
+	Strategy         BucketStrategy[P]
+	Interval         Interval[P]
+	// Concurrency is the maximum number of partition keys queried at once, which defaults to DefaultBucketConcurrency
+	Concurrency int
+}
+
+// QueryBuckets queries every partition key of the BucketStrategy within the Interval of the BucketQuery concurrently,
+// following LastEvaluatedKey until each partition key is exhausted, and unmarshals the items in chronological order
+// of their sort key. Items with the same sort key are ordered by bucket and then shard.
+//
+// The first error returned by the client cancels the remaining queries and is returned, as is any cancellation of ctx
+func QueryBuckets[T any, P Precision](ctx context.Context, client dynamodb.QueryAPIClient, q BucketQuery[P]) ([]T, error) {
+	keys, err := q.Strategy.PartitionKeys(q.Interval)
+	if err != nil {
+		return nil, err
+	}
+	skCondition, err := q.Interval.KeyCondition(q.SortKeyName)
+	if err != nil {
+		return nil, err
+	}
+	inputs := make([]*dynamodb.QueryInput, len(keys))
+	for i, key := range keys {
+		keyCondition := expression.Key(q.PartitionKeyName).Equal(expression.Value(key.String())).And(skCondition)
+		if inputs[i], err = newQueryInput(q.TableName, q.IndexName, keyCondition); err != nil {
+			return nil, err
+		}
+	}
+
+	results, err := scatter(ctx, client, inputs, q.Concurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []map[string]types.AttributeValue
+	for _, result := range results {
+		items = append(items, result...)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return sortKey(items[i], q.SortKeyName) < sortKey(items[j], q.SortKeyName)
+	})
+	var out []T
+	if err := attributevalue.UnmarshalListOfMaps(items, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// newQueryInput is a helper function to build a dynamodb.QueryInput of a table, or of its index if indexName is not empty
+func newQueryInput(tableName, indexName string, keyCondition expression.KeyConditionBuilder) (*dynamodb.QueryInput, error) {
+	expr, err := expression.NewBuilder().WithKeyCondition(keyCondition).Build()
+	if err != nil {
+		return nil, err
+	}
+	input := &dynamodb.QueryInput{
+		TableName:                 aws.String(tableName),
+		KeyConditionExpression:    expr.KeyCondition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	}
+	if indexName != "" {
+		input.IndexName = aws.String(indexName)
+	}
+	return input, nil
+}
+
+// scatter is a helper function to run every query input with at most concurrency queries at once, returning the
+// items of each query input in the same order as the inputs
+func scatter(ctx context.Context, client dynamodb.QueryAPIClient, inputs []*dynamodb.QueryInput, concurrency int) ([][]map[string]types.AttributeValue, error) {
+	if concurrency <= 0 {
+		concurrency = DefaultBucketConcurrency
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		results  = make([][]map[string]types.AttributeValue, len(inputs))
+		jobs     = make(chan int)
+	)
+	for w := 0; w < min(concurrency, len(inputs)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				items, err := queryAll(ctx, client, inputs[i])
+				if err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+				results[i] = items
+			}
+		}()
+	}
+
+send:
+	for i := range inputs {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// queryAll is a helper function to return the items of every page of a query input
+func queryAll(ctx context.Context, client dynamodb.QueryAPIClient, input *dynamodb.QueryInput) ([]map[string]types.AttributeValue, error) {
+	var items []map[string]types.AttributeValue
+	paginator := dynamodb.NewQueryPaginator(client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+	}
+	return items, nil
+}
+
+// sortKey is a helper function to return the string value of the sort key of an item, or an empty string if it is absent
+func sortKey(item map[string]types.AttributeValue, name string) string {
+	if tv, ok := item[name].(*types.AttributeValueMemberS); ok {
+		return tv.Value
+	}
+	return ""
+}
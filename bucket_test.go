@@ -0,0 +1,270 @@
+package dynamocity_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/edwardsmatt/dynamocity"
+)
+
+// fakeQueryClient is a dynamodb.QueryAPIClient over in memory items with a pk and sk, which supports a partition key
+// equality condition with an sk BETWEEN condition, and returns pages of at most pageSize items
+type fakeQueryClient struct {
+	items            map[string][]map[string]types.AttributeValue
+	partitionKeyName string
+	pageSize         int
+	fail             string
+	active           int32
+	peak             int32
+	mu               sync.Mutex
+	queried          []string
+}
+
+// newFakeQueryClient is a factory function for creating a fakeQueryClient containing the supplied pk and sk values
+func newFakeQueryClient(pageSize int, keys ...[2]string) *fakeQueryClient {
+	client := &fakeQueryClient{items: map[string][]map[string]types.AttributeValue{}, partitionKeyName: "pk", pageSize: pageSize}
+	for _, key := range keys {
+		client.items[key[0]] = append(client.items[key[0]], map[string]types.AttributeValue{
+			"pk": &types.AttributeValueMemberS{Value: key[0]},
+			"sk": &types.AttributeValueMemberS{Value: key[1]},
+		})
+	}
+	for _, items := range client.items {
+		sort.Slice(items, func(i, j int) bool {
+			return items[i]["sk"].(*types.AttributeValueMemberS).Value < items[j]["sk"].(*types.AttributeValueMemberS).Value
+		})
+	}
+	return client
+}
+
+// Query implements the dynamodb.QueryAPIClient interface, recording the peak number of concurrent queries
+func (c *fakeQueryClient) Query(ctx context.Context, params *dynamodb.QueryInput, optFns ...func(*dynamodb.Options)) (*dynamodb.QueryOutput, error) {
+	defer atomic.AddInt32(&c.active, -1)
+	active := atomic.AddInt32(&c.active, 1)
+	for peak := atomic.LoadInt32(&c.peak); active > peak && !atomic.CompareAndSwapInt32(&c.peak, peak, active); peak = atomic.LoadInt32(&c.peak) {
+	}
+	time.Sleep(time.Millisecond)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	pkAlias := partitionKeyValueAlias(params, c.partitionKeyName)
+	if pkAlias == "" {
+		return nil, errors.New("no partition key condition")
+	}
+	var pk string
+	var bounds []string
+	for alias, av := range params.ExpressionAttributeValues {
+		value := av.(*types.AttributeValueMemberS).Value
+		if alias == pkAlias {
+			pk = value
+			continue
+		}
+		bounds = append(bounds, value)
+	}
+	sort.Strings(bounds)
+	if len(bounds) == 1 {
+		bounds = append(bounds, bounds[0])
+	}
+
+	c.mu.Lock()
+	c.queried = append(c.queried, pk)
+	c.mu.Unlock()
+	if pk == c.fail {
+		return nil, errors.New("throttled")
+	}
+
+	pageSize := c.pageSize
+	if params.Limit != nil {
+		pageSize = int(*params.Limit)
+	}
+	descending := params.ScanIndexForward != nil && !*params.ScanIndexForward
+	items := append([]map[string]types.AttributeValue{}, c.items[pk]...)
+	if descending {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+
+	var page []map[string]types.AttributeValue
+	for _, item := range items {
+		sk := item["sk"].(*types.AttributeValueMemberS).Value
+		if sk < bounds[0] || sk > bounds[1] {
+			continue
+		}
+		if params.ExclusiveStartKey != nil {
+			start := params.ExclusiveStartKey["sk"].(*types.AttributeValueMemberS).Value
+			if (!descending && sk <= start) || (descending && sk >= start) {
+				continue
+			}
+		}
+		if len(page) == pageSize {
+			return &dynamodb.QueryOutput{Items: page, LastEvaluatedKey: page[len(page)-1]}, nil
+		}
+		page = append(page, item)
+	}
+	return &dynamodb.QueryOutput{Items: page}, nil
+}
+
+// partitionKeyValueAlias is a helper function to find the expression attribute value alias which the key condition
+// compares with the partition key attribute, for example ":0" in "(#0 = :0) AND (#1 BETWEEN :1 AND :2)"
+func partitionKeyValueAlias(params *dynamodb.QueryInput, partitionKeyName string) string {
+	fields := strings.Fields(strings.NewReplacer("(", " ", ")", " ").Replace(*params.KeyConditionExpression))
+	for i := 0; i+2 < len(fields); i++ {
+		if params.ExpressionAttributeNames[fields[i]] == partitionKeyName && fields[i+1] == "=" {
+			return fields[i+2]
+		}
+	}
+	return ""
+}
+
+func Test_BucketStrategyPartitionKeys(t *testing.T) {
+	hourly := dynamocity.BucketStrategy[dynamocity.MillisPrecision]{Prefix: "EVENTS", Granularity: dynamocity.HourGranularity}
+	sharded := dynamocity.BucketStrategy[dynamocity.MillisPrecision]{Prefix: "EVENTS", Granularity: dynamocity.DayGranularity, Shards: 2}
+	monthly := dynamocity.BucketStrategy[dynamocity.MillisPrecision]{Prefix: "EVENTS", Granularity: dynamocity.MonthGranularity}
+
+	instant := time.Date(2020, time.April, 2, 0, 30, 0, 0, time.FixedZone("AEST", 10*60*60))
+	if key := hourly.PartitionKey(dynamocity.MillisTime(instant), "").String(); key != "EVENTS#2020-04-01T14" {
+		t.Errorf("Unexpected PartitionKey. Expected 'EVENTS#2020-04-01T14', Got '%s'", key)
+	}
+	first := sharded.PartitionKey(dynamocity.MillisTime(instant), "order-1").String()
+	if first != sharded.PartitionKey(dynamocity.MillisTime(instant), "order-1").String() || !strings.HasPrefix(first, "EVENTS#2020-04-01#") {
+		t.Errorf("Expected a stable sharded PartitionKey. Got '%s'", first)
+	}
+
+	cases := []struct {
+		name     string
+		strategy dynamocity.BucketStrategy[dynamocity.MillisPrecision]
+		interval dynamocity.MillisInterval
+		expected string
+	}{
+		{
+			name:     "Given a half-open interval ending on a bucket boundary, then exclude the final bucket",
+			strategy: hourly,
+			interval: dynamocity.NewInterval(millisAt(14, 30), millisAt(16, 0)),
+			expected: "EVENTS#2020-04-01T14,EVENTS#2020-04-01T15",
+		},
+		{
+			name:     "Given an inclusive end on a bucket boundary, then include the final bucket",
+			strategy: hourly,
+			interval: dynamocity.MillisInterval{Start: millisAt(14, 30), End: millisAt(16, 0), StartInclusive: true, EndInclusive: true},
+			expected: "EVENTS#2020-04-01T14,EVENTS#2020-04-01T15,EVENTS#2020-04-01T16",
+		},
+		{
+			name:     "Given shards, then include every shard of each bucket",
+			strategy: sharded,
+			interval: dynamocity.NewInterval(millisAt(14, 0), dynamocity.MillisTime(millisAt(14, 0).Time().Add(24*time.Hour))),
+			expected: "EVENTS#2020-04-01#0,EVENTS#2020-04-01#1,EVENTS#2020-04-02#0,EVENTS#2020-04-02#1",
+		},
+		{
+			name:     "Given months of different lengths, then step by calendar month",
+			strategy: monthly,
+			interval: dynamocity.NewInterval(dynamocity.MillisTime(time.Date(2020, time.January, 31, 0, 0, 0, 0, time.UTC)), dynamocity.MillisTime(time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC))),
+			expected: "EVENTS#2020-01,EVENTS#2020-02",
+		},
+	}
+
+	for _, tc := range cases {
+		keys, err := tc.strategy.PartitionKeys(tc.interval)
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		actual := make([]string, len(keys))
+		for i, key := range keys {
+			actual[i] = key.String()
+		}
+		if strings.Join(actual, ",") != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, strings.Join(actual, ","))
+		}
+	}
+
+	if _, err := (dynamocity.BucketStrategy[dynamocity.MillisPrecision]{Prefix: "EVENTS"}).PartitionKeys(dynamocity.NewInterval(millisAt(14, 0), millisAt(15, 0))); err == nil {
+		t.Errorf("Expected an error for an unknown Granularity")
+	}
+
+	years := dynamocity.NewInterval(millisAt(14, 0), dynamocity.MillisTime(millisAt(14, 0).Time().AddDate(3, 0, 0)))
+	seconds := dynamocity.BucketStrategy[dynamocity.MillisPrecision]{Prefix: "EVENTS", Granularity: dynamocity.SecondGranularity, Shards: 16}
+	if _, err := seconds.PartitionKeys(years); err == nil {
+		t.Errorf("Expected an error for an Interval which spans more than the default maximum partition keys")
+	}
+
+	limited := dynamocity.BucketStrategy[dynamocity.MillisPrecision]{Prefix: "EVENTS", Granularity: dynamocity.HourGranularity, Shards: 2, MaxPartitionKeys: 4}
+	if keys, err := limited.PartitionKeys(dynamocity.NewInterval(millisAt(14, 0), millisAt(16, 0))); err != nil || len(keys) != 4 {
+		t.Errorf("Expected the maximum partition keys to be allowed. Got '%d' keys and '%v'", len(keys), err)
+	}
+	if _, err := limited.PartitionKeys(dynamocity.NewInterval(millisAt(14, 0), millisAt(17, 0))); err == nil {
+		t.Errorf("Expected an error for an Interval which spans more than MaxPartitionKeys")
+	}
+}
+
+func Test_QueryBuckets(t *testing.T) {
+	type Event struct {
+		PK string                `dynamodbav:"pk"`
+		SK dynamocity.MillisTime `dynamodbav:"sk"`
+	}
+
+	strategy := dynamocity.BucketStrategy[dynamocity.MillisPrecision]{Prefix: "EVENTS", Granularity: dynamocity.HourGranularity, Shards: 2}
+	client := newFakeQueryClient(2,
+		[2]string{"EVENTS#2020-04-01T13#0", "2020-04-01T13:59:59.999Z"},
+		[2]string{"EVENTS#2020-04-01T14#1", "2020-04-01T14:45:00.000Z"},
+		[2]string{"EVENTS#2020-04-01T14#0", "2020-04-01T14:00:00.000Z"},
+		[2]string{"EVENTS#2020-04-01T14#0", "2020-04-01T14:30:00.000Z"},
+		[2]string{"EVENTS#2020-04-01T14#0", "2020-04-01T14:50:00.000Z"},
+		[2]string{"EVENTS#2020-04-01T15#1", "2020-04-01T15:10:00.000Z"},
+		[2]string{"EVENTS#2020-04-01T14#1", "2020-04-01T14:15:00.000Z"},
+		[2]string{"EVENTS#2020-04-01T16#0", "2020-04-01T16:00:00.000Z"},
+	)
+
+	query := dynamocity.BucketQuery[dynamocity.MillisPrecision]{
+		TableName:        "events",
+		PartitionKeyName: "pk",
+		SortKeyName:      "sk",
+		Strategy:         strategy,
+		Interval:         dynamocity.NewInterval(millisAt(14, 0), millisAt(16, 0)),
+		Concurrency:      2,
+	}
+
+	events, err := dynamocity.QueryBuckets[Event](context.Background(), client, query)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	expected := []string{"14:00", "14:15", "14:30", "14:45", "14:50", "15:10"}
+	if len(events) != len(expected) {
+		t.Errorf("Expected %d events, Got %d: %v", len(expected), len(events), events)
+		t.FailNow()
+	}
+	for i, event := range events {
+		if actual := event.SK.Time().Format("15:04"); actual != expected[i] {
+			t.Errorf("Expected chronological order at index %d. Expected '%s', Got '%s'", i, expected[i], actual)
+		}
+	}
+	if len(client.queried) < 4 {
+		t.Errorf("Expected every shard of every bucket to be queried. Got '%v'", client.queried)
+	}
+	if client.peak > 2 {
+		t.Errorf("Expected at most 2 concurrent queries. Got %d", client.peak)
+	}
+
+	client.fail = "EVENTS#2020-04-01T15#0"
+	if _, err := dynamocity.QueryBuckets[Event](context.Background(), client, query); err == nil || err.Error() != "throttled" {
+		t.Errorf("Expected the client error to be returned. Got '%v'", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	client.fail = ""
+	if _, err := dynamocity.QueryBuckets[Event](ctx, client, query); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected a cancelled context to be returned. Got '%v'", err)
+	}
+}
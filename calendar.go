@@ -0,0 +1,600 @@
+package dynamocity
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// StrictYearMonthFmt is the layout of a dynamocity.YearMonth. For example: `2006-01`
+const StrictYearMonthFmt = "2006-01"
+
+// StrictYearFmt is the layout of a dynamocity.Year. For example: `2006`
+const StrictYearFmt = "2006"
+
+// YearMonth represents a sortable calendar month with the fixed format "YYYY-MM".
+//
+// YearMonth implements attributevalue.Marshaler specifically for the "YYYY-MM" format; however, it can unmarshal
+// from any "YYYY-MM-DD" date or RFC3339 Timestamp with nanosecond precision.
+type YearMonth time.Time
+
+// Year represents a sortable calendar year with the fixed format "YYYY".
+//
+// Year implements attributevalue.Marshaler specifically for the "YYYY" format; however, it can unmarshal
+// from any "YYYY-MM" month, "YYYY-MM-DD" date or RFC3339 Timestamp with nanosecond precision.
+type Year time.Time
+
+// ISOWeek represents a sortable ISO 8601 week with the fixed format "YYYY-Www", where YYYY is the ISO 8601 week-numbering
+// year, which may differ from the calendar year for the first and last days of a year.
+//
+// ISOWeek implements attributevalue.Marshaler specifically for the "YYYY-Www" format; however, it can unmarshal
+// from any "YYYY-MM-DD" date or RFC3339 Timestamp with nanosecond precision.
+type ISOWeek time.Time
+
+// Quarter represents a sortable calendar quarter with the fixed format "YYYY-Qq".
+//
+// Quarter implements attributevalue.Marshaler specifically for the "YYYY-Qq" format; however, it can unmarshal
+// from any "YYYY-MM" month, "YYYY-MM-DD" date or RFC3339 Timestamp with nanosecond precision.
+type Quarter time.Time
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal
+// a dynamocity.YearMonth into a DynamoDB AttributeValue string value
+func (t YearMonth) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return t.attributeValue(), nil
+}
+
+// attributeValue is a helper function to marshal a dynamocity.YearMonth into a string AttributeValue
+func (t YearMonth) attributeValue() types.AttributeValue {
+	return &types.AttributeValueMemberS{
+		Value: t.String(),
+	}
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal
+// a types.AttributeValue into a dynamocity.YearMonth. A NULL AttributeValue is unmarshalled as a zero value
+func (t *YearMonth) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	parsedTime, err := unmarshalCalendar(av, reflect.TypeOf((*YearMonth)(nil)), parseYearMonth)
+	if err != nil {
+		return err
+	}
+	*t = YearMonth(parsedTime)
+	return nil
+}
+
+// Time is a handler func to return an instance of dynamocity.YearMonth as time.Time
+func (t YearMonth) Time() time.Time {
+	return time.Time(t)
+}
+
+// acceptsLegacy implements the legacyTarget interface to allow a dynamocity.LegacyDecoder to decode a dynamocity.YearMonth
+func (t YearMonth) acceptsLegacy() bool {
+	return true
+}
+
+// IsZero reports whether this dynamocity.YearMonth represents the zero time instant
+func (t YearMonth) IsZero() bool {
+	return t.Time().IsZero()
+}
+
+// String implements the fmt.Stringer interface to supply the month in the format YYYY-MM
+func (t YearMonth) String() string {
+	return t.Time().Format(StrictYearMonthFmt)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal a month, date or RFC3339 timestamp. A JSON null is a no-op
+func (t *YearMonth) UnmarshalJSON(b []byte) error {
+	parsedTime, err := unmarshalCalendarJSON(b, parseYearMonth)
+	if err != nil || parsedTime == nil {
+		return err
+	}
+	*t = YearMonth(*parsedTime)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal a month in the format YYYY-MM
+func (t YearMonth) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(t.String())), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface to unmarshal a month, date or RFC3339 timestamp
+func (t *YearMonth) UnmarshalText(b []byte) error {
+	parsedTime, err := parseYearMonth(string(b))
+	if err != nil {
+		return err
+	}
+	*t = YearMonth(parsedTime)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface to marshal a month in the format YYYY-MM
+func (t YearMonth) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// Date returns the first day of this dynamocity.YearMonth
+func (t YearMonth) Date() Date {
+	year, month, _ := t.Time().Date()
+	return Date(time.Date(year, month, 1, 0, 0, 0, 0, t.Time().Location()))
+}
+
+// Year returns the dynamocity.Year of this dynamocity.YearMonth
+func (t YearMonth) Year() Year {
+	return Year(t)
+}
+
+// Quarter returns the dynamocity.Quarter of this dynamocity.YearMonth
+func (t YearMonth) Quarter() Quarter {
+	return Quarter(t)
+}
+
+// ParseYearMonth will attempt to parse a month with format YYYY-MM, a date with format YYYY-MM-DD or any RFC3339
+// Timestamp to a dynamocity.YearMonth
+func ParseYearMonth(str string) (YearMonth, error) {
+	parsedTime, err := parseYearMonth(str)
+	return YearMonth(parsedTime), err
+}
+
+// parseYearMonth is a helper function to parse a month, date or RFC3339 Timestamp to a time.Time
+func parseYearMonth(str string) (time.Time, error) {
+	if parsedTime, err := time.Parse(StrictYearMonthFmt, str); err == nil {
+		return parsedTime, nil
+	}
+	parsedTime, err := parse(str)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Month '%s' cannot be unmarshalled", str)
+	}
+	return parsedTime, nil
+}
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal
+// a dynamocity.Year into a DynamoDB AttributeValue string value
+func (t Year) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return t.attributeValue(), nil
+}
+
+// attributeValue is a helper function to marshal a dynamocity.Year into a string AttributeValue
+func (t Year) attributeValue() types.AttributeValue {
+	return &types.AttributeValueMemberS{
+		Value: t.String(),
+	}
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal
+// a types.AttributeValue into a dynamocity.Year. A NULL AttributeValue is unmarshalled as a zero value
+func (t *Year) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	parsedTime, err := unmarshalCalendar(av, reflect.TypeOf((*Year)(nil)), parseYear)
+	if err != nil {
+		return err
+	}
+	*t = Year(parsedTime)
+	return nil
+}
+
+// Time is a handler func to return an instance of dynamocity.Year as time.Time
+func (t Year) Time() time.Time {
+	return time.Time(t)
+}
+
+// acceptsLegacy implements the legacyTarget interface to allow a dynamocity.LegacyDecoder to decode a dynamocity.Year
+func (t Year) acceptsLegacy() bool {
+	return true
+}
+
+// IsZero reports whether this dynamocity.Year represents the zero time instant
+func (t Year) IsZero() bool {
+	return t.Time().IsZero()
+}
+
+// String implements the fmt.Stringer interface to supply the year in the format YYYY
+func (t Year) String() string {
+	return t.Time().Format(StrictYearFmt)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal a year, month, date or RFC3339 timestamp. A JSON null is a no-op
+func (t *Year) UnmarshalJSON(b []byte) error {
+	parsedTime, err := unmarshalCalendarJSON(b, parseYear)
+	if err != nil || parsedTime == nil {
+		return err
+	}
+	*t = Year(*parsedTime)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal a year in the format YYYY
+func (t Year) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(t.String())), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface to unmarshal a year, month, date or RFC3339 timestamp
+func (t *Year) UnmarshalText(b []byte) error {
+	parsedTime, err := parseYear(string(b))
+	if err != nil {
+		return err
+	}
+	*t = Year(parsedTime)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface to marshal a year in the format YYYY
+func (t Year) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// Date returns the first day of this dynamocity.Year
+func (t Year) Date() Date {
+	return Date(time.Date(t.Time().Year(), time.January, 1, 0, 0, 0, 0, t.Time().Location()))
+}
+
+// YearMonth returns the first dynamocity.YearMonth of this dynamocity.Year
+func (t Year) YearMonth() YearMonth {
+	return YearMonth(t.Date())
+}
+
+// Quarter returns the first dynamocity.Quarter of this dynamocity.Year
+func (t Year) Quarter() Quarter {
+	return Quarter(t.Date())
+}
+
+// ParseYear will attempt to parse a year with format YYYY, a month with format YYYY-MM, a date with format YYYY-MM-DD
+// or any RFC3339 Timestamp to a dynamocity.Year
+func ParseYear(str string) (Year, error) {
+	parsedTime, err := parseYear(str)
+	return Year(parsedTime), err
+}
+
+// parseYear is a helper function to parse a year, month, date or RFC3339 Timestamp to a time.Time
+func parseYear(str string) (time.Time, error) {
+	if parsedTime, err := time.Parse(StrictYearFmt, str); err == nil {
+		return parsedTime, nil
+	}
+	parsedTime, err := parseYearMonth(str)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Year '%s' cannot be unmarshalled", str)
+	}
+	return parsedTime, nil
+}
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal
+// a dynamocity.ISOWeek into a DynamoDB AttributeValue string value
+func (t ISOWeek) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return t.attributeValue(), nil
+}
+
+// attributeValue is a helper function to marshal a dynamocity.ISOWeek into a string AttributeValue
+func (t ISOWeek) attributeValue() types.AttributeValue {
+	return &types.AttributeValueMemberS{
+		Value: t.String(),
+	}
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal
+// a types.AttributeValue into a dynamocity.ISOWeek. A NULL AttributeValue is unmarshalled as a zero value
+func (t *ISOWeek) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	parsedTime, err := unmarshalCalendar(av, reflect.TypeOf((*ISOWeek)(nil)), parseISOWeek)
+	if err != nil {
+		return err
+	}
+	*t = ISOWeek(parsedTime)
+	return nil
+}
+
+// Time is a handler func to return an instance of dynamocity.ISOWeek as time.Time
+func (t ISOWeek) Time() time.Time {
+	return time.Time(t)
+}
+
+// acceptsLegacy implements the legacyTarget interface to allow a dynamocity.LegacyDecoder to decode a dynamocity.ISOWeek
+func (t ISOWeek) acceptsLegacy() bool {
+	return true
+}
+
+// IsZero reports whether this dynamocity.ISOWeek represents the zero time instant
+func (t ISOWeek) IsZero() bool {
+	return t.Time().IsZero()
+}
+
+// String implements the fmt.Stringer interface to supply the ISO 8601 week in the format YYYY-Www
+func (t ISOWeek) String() string {
+	year, week := t.Time().ISOWeek()
+	return fmt.Sprintf("%04d-W%02d", year, week)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal an ISO 8601 week, date or RFC3339 timestamp. A JSON null is a no-op
+func (t *ISOWeek) UnmarshalJSON(b []byte) error {
+	parsedTime, err := unmarshalCalendarJSON(b, parseISOWeek)
+	if err != nil || parsedTime == nil {
+		return err
+	}
+	*t = ISOWeek(*parsedTime)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal an ISO 8601 week in the format YYYY-Www
+func (t ISOWeek) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(t.String())), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface to unmarshal an ISO 8601 week, date or RFC3339 timestamp
+func (t *ISOWeek) UnmarshalText(b []byte) error {
+	parsedTime, err := parseISOWeek(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ISOWeek(parsedTime)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface to marshal an ISO 8601 week in the format YYYY-Www
+func (t ISOWeek) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// Date returns the Monday which starts this dynamocity.ISOWeek
+func (t ISOWeek) Date() Date {
+	daysSinceMonday := (int(t.Time().Weekday()) + 6) % 7
+	return Date(midnight(t.Time()).AddDate(0, 0, -daysSinceMonday))
+}
+
+// YearMonth returns the dynamocity.YearMonth which contains the Thursday of this dynamocity.ISOWeek
+func (t ISOWeek) YearMonth() YearMonth {
+	return YearMonth(t.thursday())
+}
+
+// Year returns the ISO 8601 week-numbering year of this dynamocity.ISOWeek, which is the dynamocity.Year that
+// contains its Thursday
+func (t ISOWeek) Year() Year {
+	return Year(t.thursday())
+}
+
+// Quarter returns the dynamocity.Quarter which contains the Thursday of this dynamocity.ISOWeek
+func (t ISOWeek) Quarter() Quarter {
+	return Quarter(t.thursday())
+}
+
+// thursday is a helper function to return the Thursday of this dynamocity.ISOWeek, which determines the ISO 8601
+// week-numbering year; so that a week which spans two months is assigned to the month with the most of its days
+func (t ISOWeek) thursday() time.Time {
+	return t.Date().Time().AddDate(0, 0, 3)
+}
+
+// ParseISOWeek will attempt to parse an ISO 8601 week with format YYYY-Www, a date with format YYYY-MM-DD or any RFC3339
+// Timestamp to a dynamocity.ISOWeek
+func ParseISOWeek(str string) (ISOWeek, error) {
+	parsedTime, err := parseISOWeek(str)
+	return ISOWeek(parsedTime), err
+}
+
+// parseISOWeek is a helper function to parse an ISO 8601 week, date or RFC3339 Timestamp to a time.Time. An ISO 8601
+// week is parsed as the Monday which starts the week
+func parseISOWeek(str string) (time.Time, error) {
+	if year, week, ok := parseCalendarNumbers(str, "-W", 2); ok {
+		// The 4th of January is always in the first ISO 8601 week of the year
+		fourthOfJanuary := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+		monday := fourthOfJanuary.AddDate(0, 0, (week-1)*7-(int(fourthOfJanuary.Weekday())+6)%7)
+		if actualYear, actualWeek := monday.ISOWeek(); actualYear == year && actualWeek == week {
+			return monday, nil
+		}
+		return time.Time{}, fmt.Errorf("ISO week '%s' does not exist", str)
+	}
+	parsedTime, err := parse(str)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("ISO week '%s' cannot be unmarshalled", str)
+	}
+	return parsedTime, nil
+}
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal
+// a dynamocity.Quarter into a DynamoDB AttributeValue string value
+func (t Quarter) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return t.attributeValue(), nil
+}
+
+// attributeValue is a helper function to marshal a dynamocity.Quarter into a string AttributeValue
+func (t Quarter) attributeValue() types.AttributeValue {
+	return &types.AttributeValueMemberS{
+		Value: t.String(),
+	}
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal
+// a types.AttributeValue into a dynamocity.Quarter. A NULL AttributeValue is unmarshalled as a zero value
+func (t *Quarter) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	parsedTime, err := unmarshalCalendar(av, reflect.TypeOf((*Quarter)(nil)), parseQuarter)
+	if err != nil {
+		return err
+	}
+	*t = Quarter(parsedTime)
+	return nil
+}
+
+// Time is a handler func to return an instance of dynamocity.Quarter as time.Time
+func (t Quarter) Time() time.Time {
+	return time.Time(t)
+}
+
+// acceptsLegacy implements the legacyTarget interface to allow a dynamocity.LegacyDecoder to decode a dynamocity.Quarter
+func (t Quarter) acceptsLegacy() bool {
+	return true
+}
+
+// IsZero reports whether this dynamocity.Quarter represents the zero time instant
+func (t Quarter) IsZero() bool {
+	return t.Time().IsZero()
+}
+
+// String implements the fmt.Stringer interface to supply the quarter in the format YYYY-Qq
+func (t Quarter) String() string {
+	return fmt.Sprintf("%04d-Q%d", t.Time().Year(), t.Number())
+}
+
+// Number returns the number of this dynamocity.Quarter within its year, from 1 to 4
+func (t Quarter) Number() int {
+	return (int(t.Time().Month())-1)/3 + 1
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal a quarter, month, date or RFC3339 timestamp. A JSON null is a no-op
+func (t *Quarter) UnmarshalJSON(b []byte) error {
+	parsedTime, err := unmarshalCalendarJSON(b, parseQuarter)
+	if err != nil || parsedTime == nil {
+		return err
+	}
+	*t = Quarter(*parsedTime)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal a quarter in the format YYYY-Qq
+func (t Quarter) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(t.String())), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface to unmarshal a quarter, month, date or RFC3339 timestamp
+func (t *Quarter) UnmarshalText(b []byte) error {
+	parsedTime, err := parseQuarter(string(b))
+	if err != nil {
+		return err
+	}
+	*t = Quarter(parsedTime)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface to marshal a quarter in the format YYYY-Qq
+func (t Quarter) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// Date returns the first day of this dynamocity.Quarter
+func (t Quarter) Date() Date {
+	return t.YearMonth().Date()
+}
+
+// YearMonth returns the first dynamocity.YearMonth of this dynamocity.Quarter
+func (t Quarter) YearMonth() YearMonth {
+	firstMonth := time.Month((t.Number()-1)*3 + 1)
+	return YearMonth(time.Date(t.Time().Year(), firstMonth, 1, 0, 0, 0, 0, t.Time().Location()))
+}
+
+// Year returns the dynamocity.Year of this dynamocity.Quarter
+func (t Quarter) Year() Year {
+	return Year(t)
+}
+
+// ParseQuarter will attempt to parse a quarter with format YYYY-Qq, a month with format YYYY-MM, a date with format
+// YYYY-MM-DD or any RFC3339 Timestamp to a dynamocity.Quarter
+func ParseQuarter(str string) (Quarter, error) {
+	parsedTime, err := parseQuarter(str)
+	return Quarter(parsedTime), err
+}
+
+// parseQuarter is a helper function to parse a quarter, month, date or RFC3339 Timestamp to a time.Time. A quarter is
+// parsed as the first day of the quarter
+func parseQuarter(str string) (time.Time, error) {
+	if year, quarter, ok := parseCalendarNumbers(str, "-Q", 1); ok {
+		if quarter < 1 || quarter > 4 {
+			return time.Time{}, fmt.Errorf("Quarter '%s' does not exist", str)
+		}
+		return time.Date(year, time.Month((quarter-1)*3+1), 1, 0, 0, 0, 0, time.UTC), nil
+	}
+	parsedTime, err := parseYearMonth(str)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Quarter '%s' cannot be unmarshalled", str)
+	}
+	return parsedTime, nil
+}
+
+// YearMonth returns the dynamocity.YearMonth of this dynamocity.Date
+func (t Date) YearMonth() YearMonth {
+	return YearMonth(t)
+}
+
+// Year returns the dynamocity.Year of this dynamocity.Date
+func (t Date) Year() Year {
+	return Year(t)
+}
+
+// ISOWeek returns the dynamocity.ISOWeek of this dynamocity.Date
+func (t Date) ISOWeek() ISOWeek {
+	return ISOWeek(t)
+}
+
+// Quarter returns the dynamocity.Quarter of this dynamocity.Date
+func (t Date) Quarter() Quarter {
+	return Quarter(t)
+}
+
+// Date returns the dynamocity.Date of this dynamocity.Time, normalised to UTC unless P is a dynamocity.Offset precision
+func (t Time[P]) Date() Date {
+	return Date(normalise[P](t.Time()))
+}
+
+// YearMonth returns the dynamocity.YearMonth of this dynamocity.Time, normalised to UTC unless P is a dynamocity.Offset precision
+func (t Time[P]) YearMonth() YearMonth {
+	return YearMonth(normalise[P](t.Time()))
+}
+
+// Year returns the dynamocity.Year of this dynamocity.Time, normalised to UTC unless P is a dynamocity.Offset precision
+func (t Time[P]) Year() Year {
+	return Year(normalise[P](t.Time()))
+}
+
+// ISOWeek returns the dynamocity.ISOWeek of this dynamocity.Time, normalised to UTC unless P is a dynamocity.Offset precision
+func (t Time[P]) ISOWeek() ISOWeek {
+	return ISOWeek(normalise[P](t.Time()))
+}
+
+// Quarter returns the dynamocity.Quarter of this dynamocity.Time, normalised to UTC unless P is a dynamocity.Offset precision
+func (t Time[P]) Quarter() Quarter {
+	return Quarter(normalise[P](t.Time()))
+}
+
+// unmarshalCalendar is a helper function to unmarshal a string AttributeValue with the supplied parse function.
+// A NULL AttributeValue is unmarshalled as a zero time.Time
+func unmarshalCalendar(av types.AttributeValue, typ reflect.Type, parseFn func(string) (time.Time, error)) (time.Time, error) {
+	if isNull(av) {
+		return time.Time{}, nil
+	}
+	tv, ok := av.(*types.AttributeValueMemberS)
+	if !ok {
+		return time.Time{}, &attributevalue.UnmarshalTypeError{
+			Value: fmt.Sprintf("%T", av),
+			Type:  typ,
+		}
+	}
+	return parseFn(tv.Value)
+}
+
+// parseCalendarNumbers is a helper function to parse a four digit year, followed by the separator and then a number with
+// a fixed number of digits. For example: `2020-W14`
+func parseCalendarNumbers(str string, separator string, digits int) (year int, number int, ok bool) {
+	if len(str) != 4+len(separator)+digits || str[4:4+len(separator)] != separator {
+		return 0, 0, false
+	}
+	for _, r := range str[:4] + str[4+len(separator):] {
+		if r < '0' || r > '9' {
+			return 0, 0, false
+		}
+	}
+	year, _ = strconv.Atoi(str[:4])
+	number, _ = strconv.Atoi(str[4+len(separator):])
+	return year, number, true
+}
+
+// unmarshalCalendarJSON is a helper function to unmarshal a JSON string with the supplied parse function. A JSON null
+// is unmarshalled as a nil *time.Time
+func unmarshalCalendarJSON(b []byte, parseFn func(string) (time.Time, error)) (*time.Time, error) {
+	if isJSONNull(b) {
+		return nil, nil
+	}
+	str, err := strconv.Unquote(string(b))
+	if err != nil {
+		return nil, err
+	}
+	parsedTime, err := parseFn(str)
+	if err != nil {
+		return nil, err
+	}
+	return &parsedTime, nil
+}
@@ -0,0 +1,344 @@
+package dynamocity_test
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/edwardsmatt/dynamocity"
+)
+
+func Test_CalendarMarshalling(t *testing.T) {
+	timestamp := time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		value    attributevalue.Marshaler
+		expected string
+	}{
+		{name: "Given a dynamocity.YearMonth, then marshal YYYY-MM", value: dynamocity.YearMonth(timestamp), expected: "2020-04"},
+		{name: "Given a dynamocity.Year, then marshal YYYY", value: dynamocity.Year(timestamp), expected: "2020"},
+		{name: "Given a dynamocity.ISOWeek, then marshal YYYY-Www", value: dynamocity.ISOWeek(timestamp), expected: "2020-W14"},
+		{name: "Given a dynamocity.Quarter, then marshal YYYY-Qq", value: dynamocity.Quarter(timestamp), expected: "2020-Q2"},
+		{
+			name:     "Given a dynamocity.ISOWeek at the start of January, then marshal the ISO week-numbering year",
+			value:    dynamocity.ISOWeek(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			expected: "2020-W53",
+		},
+		{
+			name:     "Given a dynamocity.ISOWeek at the end of December, then marshal the ISO week-numbering year",
+			value:    dynamocity.ISOWeek(time.Date(2019, time.December, 30, 0, 0, 0, 0, time.UTC)),
+			expected: "2020-W01",
+		},
+	}
+
+	for _, tc := range cases {
+		av, err := tc.value.MarshalDynamoDBAttributeValue()
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if actual := decodeAttributeValue(av, t); actual != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, actual)
+		}
+		if actual := fmt.Sprint(tc.value); actual != tc.expected {
+			t.Errorf("%s. Expected String '%s', Got '%s'", tc.name, tc.expected, actual)
+		}
+	}
+}
+
+func Test_CalendarUnmarshalling(t *testing.T) {
+	type TestType struct {
+		Month   dynamocity.YearMonth `dynamodbav:"month" json:"month"`
+		Year    dynamocity.Year      `dynamodbav:"year" json:"year"`
+		Week    dynamocity.ISOWeek   `dynamodbav:"week" json:"week"`
+		Quarter dynamocity.Quarter   `dynamodbav:"quarter" json:"quarter"`
+	}
+
+	cases := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "Given each calendar format, then unmarshal the start of each period",
+			input: `{"month":"2020-04","year":"2020","week":"2020-W14","quarter":"2020-Q2"}`,
+		},
+		{
+			name:  "Given an RFC3339 Timestamp, then unmarshal each calendar type",
+			input: `{"month":"2020-04-01T14:00:00Z","year":"2020-04-01T14:00:00Z","week":"2020-04-01T14:00:00Z","quarter":"2020-04-01T14:00:00Z"}`,
+		},
+		{
+			name:  "Given a date, then unmarshal each calendar type",
+			input: `{"month":"2020-04-01","year":"2020-04-01","week":"2020-04-01","quarter":"2020-04-01"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		var fromJSON TestType
+		if err := json.Unmarshal([]byte(tc.input), &fromJSON); err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		actual := fmt.Sprint(fromJSON.Month, fromJSON.Year, fromJSON.Week, fromJSON.Quarter)
+		if expected := "2020-04 2020 2020-W14 2020-Q2"; actual != expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, expected, actual)
+		}
+
+		item, err := attributevalue.MarshalMap(fromJSON)
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		var fromDynamo TestType
+		if err := attributevalue.UnmarshalMap(item, &fromDynamo); err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if roundTrip := fmt.Sprint(fromDynamo.Month, fromDynamo.Year, fromDynamo.Week, fromDynamo.Quarter); roundTrip != actual {
+			t.Errorf("%s. Expected '%s' after attribute value round trip, Got '%s'", tc.name, actual, roundTrip)
+		}
+	}
+}
+
+func Test_CalendarParseStartOfPeriod(t *testing.T) {
+	cases := []struct {
+		name     string
+		parse    func() (dynamocity.Date, error)
+		expected string
+	}{
+		{
+			name: "Given a YYYY-MM month, then parse the first day of the month",
+			parse: func() (dynamocity.Date, error) {
+				month, err := dynamocity.ParseYearMonth("2020-04")
+				return month.Date(), err
+			},
+			expected: "2020-04-01",
+		},
+		{
+			name: "Given a YYYY year, then parse the first day of the year",
+			parse: func() (dynamocity.Date, error) {
+				year, err := dynamocity.ParseYear("2020")
+				return year.Date(), err
+			},
+			expected: "2020-01-01",
+		},
+		{
+			name: "Given a YYYY-Www week, then parse the Monday which starts the week",
+			parse: func() (dynamocity.Date, error) {
+				week, err := dynamocity.ParseISOWeek("2020-W14")
+				return week.Date(), err
+			},
+			expected: "2020-03-30",
+		},
+		{
+			name: "Given the first ISO week of a year, then parse the Monday in the previous calendar year",
+			parse: func() (dynamocity.Date, error) {
+				week, err := dynamocity.ParseISOWeek("2020-W01")
+				return week.Date(), err
+			},
+			expected: "2019-12-30",
+		},
+		{
+			name: "Given a YYYY-Qq quarter, then parse the first day of the quarter",
+			parse: func() (dynamocity.Date, error) {
+				quarter, err := dynamocity.ParseQuarter("2020-Q3")
+				return quarter.Date(), err
+			},
+			expected: "2020-07-01",
+		},
+	}
+
+	for _, tc := range cases {
+		date, err := tc.parse()
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if date.String() != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, date)
+		}
+	}
+}
+
+func Test_CalendarParseErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		parse func() error
+	}{
+		{name: "Given week 53 of a year with 52 ISO weeks, then error", parse: func() error { _, err := dynamocity.ParseISOWeek("2021-W53"); return err }},
+		{name: "Given week 0, then error", parse: func() error { _, err := dynamocity.ParseISOWeek("2020-W00"); return err }},
+		{name: "Given a week with a sign, then error", parse: func() error { _, err := dynamocity.ParseISOWeek("2020-W+1"); return err }},
+		{name: "Given quarter 5, then error", parse: func() error { _, err := dynamocity.ParseQuarter("2020-Q5"); return err }},
+		{name: "Given month 13, then error", parse: func() error { _, err := dynamocity.ParseYearMonth("2020-13"); return err }},
+		{name: "Given a quarter as a year, then error", parse: func() error { _, err := dynamocity.ParseYear("2020-Q1"); return err }},
+	}
+
+	for _, tc := range cases {
+		if err := tc.parse(); err == nil {
+			t.Errorf("%s. Expected an error", tc.name)
+		}
+	}
+}
+
+func Test_CalendarConversions(t *testing.T) {
+	nanoTime := dynamocity.NanoTime(time.Date(2020, time.April, 1, 8, 0, 0, 0, time.FixedZone("AEST", 10*60*60)))
+
+	actual := fmt.Sprint(nanoTime.Date(), nanoTime.YearMonth(), nanoTime.Year(), nanoTime.ISOWeek(), nanoTime.Quarter())
+	if expected := "2020-03-31 2020-03 2020 2020-W14 2020-Q1"; actual != expected {
+		t.Errorf("Expected a dynamocity.NanoTime to convert in UTC. Expected '%s', Got '%s'", expected, actual)
+	}
+
+	date := dynamocity.Date(time.Date(2020, time.May, 17, 0, 0, 0, 0, time.UTC))
+	actual = fmt.Sprint(date.YearMonth(), date.Year(), date.ISOWeek(), date.Quarter())
+	if expected := "2020-05 2020 2020-W20 2020-Q2"; actual != expected {
+		t.Errorf("Unexpected dynamocity.Date conversions. Expected '%s', Got '%s'", expected, actual)
+	}
+
+	quarter := date.Quarter()
+	actual = fmt.Sprint(quarter.Number(), quarter.YearMonth(), quarter.Year(), date.YearMonth().Quarter(), date.YearMonth().Year())
+	if expected := "2 2020-04 2020 2020-Q2 2020"; actual != expected {
+		t.Errorf("Unexpected calendar conversions. Expected '%s', Got '%s'", expected, actual)
+	}
+
+	year := date.Year()
+	actual = fmt.Sprint(year.YearMonth(), year.Quarter())
+	if expected := "2020-01 2020-Q1"; actual != expected {
+		t.Errorf("Unexpected dynamocity.Year conversions. Expected '%s', Got '%s'", expected, actual)
+	}
+
+	weeks := []struct {
+		week     string
+		expected string
+	}{
+		{week: "2020-W14", expected: "2020-04 2020 2020-Q2"},
+		{week: "2020-W53", expected: "2020-12 2020 2020-Q4"},
+		{week: "2020-W01", expected: "2020-01 2020 2020-Q1"},
+		{week: "2021-W52", expected: "2021-12 2021 2021-Q4"},
+	}
+	for _, tc := range weeks {
+		week, err := dynamocity.ParseISOWeek(tc.week)
+		if err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+		if actual := fmt.Sprint(week.YearMonth(), week.Year(), week.Quarter()); actual != tc.expected {
+			t.Errorf("Unexpected dynamocity.ISOWeek conversions of '%s'. Expected '%s', Got '%s'", tc.week, tc.expected, actual)
+		}
+	}
+}
+
+func Test_CalendarTextAndJSONRoundTrip(t *testing.T) {
+	type roundTripper interface {
+		encoding.TextUnmarshaler
+		json.Unmarshaler
+		fmt.Stringer
+	}
+
+	timestamp := time.Date(2020, time.December, 31, 14, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		value    interface{}
+		target   func() roundTripper
+		expected string
+	}{
+		{name: "Given a dynamocity.YearMonth, then round trip", value: dynamocity.YearMonth(timestamp), target: func() roundTripper { return new(dynamocity.YearMonth) }, expected: "2020-12"},
+		{name: "Given a dynamocity.Year, then round trip", value: dynamocity.Year(timestamp), target: func() roundTripper { return new(dynamocity.Year) }, expected: "2020"},
+		{name: "Given a dynamocity.ISOWeek, then round trip", value: dynamocity.ISOWeek(timestamp), target: func() roundTripper { return new(dynamocity.ISOWeek) }, expected: "2020-W53"},
+		{name: "Given a dynamocity.Quarter, then round trip", value: dynamocity.Quarter(timestamp), target: func() roundTripper { return new(dynamocity.Quarter) }, expected: "2020-Q4"},
+	}
+
+	for _, tc := range cases {
+		text, err := tc.value.(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if string(text) != tc.expected {
+			t.Errorf("%s. Expected text '%s', Got '%s'", tc.name, tc.expected, text)
+		}
+		fromText := tc.target()
+		if err := fromText.UnmarshalText(text); err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if fromText.String() != tc.expected {
+			t.Errorf("%s. Expected '%s' after text round trip, Got '%s'", tc.name, tc.expected, fromText)
+		}
+
+		jsonBytes, err := json.Marshal(tc.value)
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if expectedJSON := `"` + tc.expected + `"`; string(jsonBytes) != expectedJSON {
+			t.Errorf("%s. Expected JSON '%s', Got '%s'", tc.name, expectedJSON, jsonBytes)
+		}
+		fromJSON := tc.target()
+		if err := json.Unmarshal(jsonBytes, fromJSON); err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if fromJSON.String() != tc.expected {
+			t.Errorf("%s. Expected '%s' after JSON round trip, Got '%s'", tc.name, tc.expected, fromJSON)
+		}
+	}
+}
+
+func Test_CalendarSortable(t *testing.T) {
+	weeks := []dynamocity.ISOWeek{
+		dynamocity.ISOWeek(time.Date(2021, time.January, 4, 0, 0, 0, 0, time.UTC)),
+		dynamocity.ISOWeek(time.Date(2020, time.January, 6, 0, 0, 0, 0, time.UTC)),
+		dynamocity.ISOWeek(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		dynamocity.ISOWeek(time.Date(2020, time.March, 2, 0, 0, 0, 0, time.UTC)),
+	}
+
+	keys := make([]string, len(weeks))
+	for i, week := range weeks {
+		keys[i] = week.String()
+	}
+	sort.Strings(keys)
+
+	sort.Slice(weeks, func(i, j int) bool { return weeks[i].Time().Before(weeks[j].Time()) })
+	for i, week := range weeks {
+		if keys[i] != week.String() {
+			t.Errorf("Expected string order to match chronological order. Expected '%s', Got '%s'", week, keys[i])
+		}
+	}
+}
+
+func Test_CalendarZero(t *testing.T) {
+	cases := []struct {
+		value    attributevalue.Marshaler
+		expected string
+	}{
+		{value: dynamocity.YearMonth{}, expected: "0001-01"},
+		{value: dynamocity.Year{}, expected: "0001"},
+		{value: dynamocity.ISOWeek{}, expected: "0001-W01"},
+		{value: dynamocity.Quarter{}, expected: "0001-Q1"},
+	}
+	for _, tc := range cases {
+		av, err := tc.value.MarshalDynamoDBAttributeValue()
+		if err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+		if actual := decodeAttributeValue(av, t); actual != tc.expected {
+			t.Errorf("Expected a zero %T to marshal as '%s'. Got '%s'", tc.value, tc.expected, actual)
+		}
+	}
+
+	month := dynamocity.YearMonth(time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC))
+	if err := attributevalue.Unmarshal(&types.AttributeValueMemberNULL{Value: true}, &month); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if !month.IsZero() {
+		t.Errorf("Expected a NULL attribute value to unmarshal as a zero dynamocity.YearMonth. Got '%s'", month)
+	}
+}
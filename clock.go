@@ -0,0 +1,100 @@
+package dynamocity
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock supplies the current time to the dynamocity Now constructors and the IDGenerator, allowing time to be
+// controlled in tests
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is a Clock which supplies the current system time
+type SystemClock struct{}
+
+// Now implements the Clock interface to supply time.Now
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// FakeClock is a Clock which supplies a fixed time, until it is Set or Advanced. FakeClock is safe for concurrent use
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock is a factory function for creating a FakeClock fixed at the supplied time
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now implements the Clock interface to supply the fixed time of this FakeClock
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Set fixes this FakeClock at the supplied time
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+}
+
+// Advance moves the fixed time of this FakeClock forward by the supplied time.Duration, or backwards if it is negative
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// clockOrSystem is a helper function to return the supplied Clock, or a SystemClock if it is nil
+func clockOrSystem(clock Clock) Clock {
+	if clock == nil {
+		return SystemClock{}
+	}
+	return clock
+}
+
+// Now returns the current time of the Clock as a dynamocity.Time, truncated to the Resolution of P. A nil Clock
+// supplies the current system time.
+//
+// For example, to freeze time in a test, inject a FakeClock into the code under test:
+//
+//	clock := dynamocity.NewFakeClock(time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC))
+//	createdAt := dynamocity.Now[dynamocity.MillisPrecision](clock)
+func Now[P Precision](clock Clock) Time[P] {
+	return Time[P](clockOrSystem(clock).Now()).Truncate()
+}
+
+// NowNano returns the current time of the Clock as a dynamocity.NanoTime. A nil Clock supplies the current system time
+func NowNano(clock Clock) NanoTime {
+	return Now[NanoPrecision](clock)
+}
+
+// NowMicros returns the current time of the Clock as a dynamocity.MicrosTime, truncated to the microsecond.
+// A nil Clock supplies the current system time
+func NowMicros(clock Clock) MicrosTime {
+	return Now[MicrosPrecision](clock)
+}
+
+// NowMillis returns the current time of the Clock as a dynamocity.MillisTime, truncated to the millisecond.
+// A nil Clock supplies the current system time
+func NowMillis(clock Clock) MillisTime {
+	return Now[MillisPrecision](clock)
+}
+
+// NowSeconds returns the current time of the Clock as a dynamocity.SecondsTime, truncated to the second.
+// A nil Clock supplies the current system time
+func NowSeconds(clock Clock) SecondsTime {
+	return Now[SecondsPrecision](clock)
+}
+
+// Today returns the current UTC date of the Clock as a dynamocity.Date at midnight. A nil Clock supplies the current
+// system time
+func Today(clock Clock) Date {
+	return Date(midnight(clockOrSystem(clock).Now().UTC()))
+}
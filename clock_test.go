@@ -0,0 +1,101 @@
+package dynamocity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edwardsmatt/dynamocity"
+)
+
+func Test_NowTruncatesToPrecision(t *testing.T) {
+	clock := dynamocity.NewFakeClock(time.Date(2020, time.April, 1, 23, 59, 59, 999999999, time.FixedZone("AEST", 10*60*60)))
+
+	cases := []struct {
+		name     string
+		now      func() string
+		expected string
+	}{
+		{name: "Given NowNano, then keep nanoseconds", now: func() string { return dynamocity.NowNano(clock).String() }, expected: "2020-04-01T13:59:59.999999999Z"},
+		{name: "Given NowMicros, then truncate to microseconds", now: func() string { return dynamocity.NowMicros(clock).String() }, expected: "2020-04-01T13:59:59.999999Z"},
+		{name: "Given NowMillis, then truncate to milliseconds", now: func() string { return dynamocity.NowMillis(clock).String() }, expected: "2020-04-01T13:59:59.999Z"},
+		{name: "Given NowSeconds, then truncate to seconds", now: func() string { return dynamocity.NowSeconds(clock).String() }, expected: "2020-04-01T13:59:59Z"},
+		{name: "Given Today, then the UTC date", now: func() string { return dynamocity.Today(clock).String() }, expected: "2020-04-01"},
+	}
+
+	for _, tc := range cases {
+		if actual := tc.now(); actual != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, actual)
+		}
+	}
+
+	if millisTime := dynamocity.NowMillis(clock); millisTime.Time().Nanosecond() != 999000000 {
+		t.Errorf("Expected the underlying time to be truncated, so that it equals the unmarshalled value. Got '%d'", millisTime.Time().Nanosecond())
+	}
+}
+
+func Test_NowWithoutClock(t *testing.T) {
+	before := time.Now().Truncate(time.Millisecond)
+	now := dynamocity.NowMillis(nil)
+	after := time.Now()
+
+	if now.Time().Before(before) || now.Time().After(after) {
+		t.Errorf("Expected a nil Clock to supply the current system time. Got '%s', between '%v' and '%v'", now, before, after)
+	}
+}
+
+func Test_FakeClock(t *testing.T) {
+	start := time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC)
+	clock := dynamocity.NewFakeClock(start)
+
+	if !clock.Now().Equal(start) {
+		t.Errorf("Unexpected FakeClock time. Expected '%v', Got '%v'", start, clock.Now())
+	}
+	clock.Advance(90 * time.Minute)
+	if expected := start.Add(90 * time.Minute); !clock.Now().Equal(expected) {
+		t.Errorf("Unexpected FakeClock time after Advance. Expected '%v', Got '%v'", expected, clock.Now())
+	}
+	clock.Set(start)
+	if !clock.Now().Equal(start) {
+		t.Errorf("Unexpected FakeClock time after Set. Expected '%v', Got '%v'", start, clock.Now())
+	}
+}
+
+func Test_IDGeneratorClock(t *testing.T) {
+	start := time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC)
+	clock := dynamocity.NewFakeClock(start)
+	generator := dynamocity.IDGenerator{Clock: clock}
+
+	first, err := generator.Next()
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	second, err := generator.Next()
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if !first.Time().Equal(start) || !second.Time().Equal(start) || second.Compare(first) <= 0 {
+		t.Errorf("Expected IDs with a frozen clock to share the Timestamp and increase. Got '%s' then '%s'", first, second)
+	}
+
+	clock.Advance(-time.Hour)
+	third, err := generator.Next()
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if third.Compare(second) <= 0 || third.String() <= second.String() {
+		t.Errorf("Expected IDs to increase when the clock moves backwards. Got '%s' then '%s'", second, third)
+	}
+
+	clock.Advance(2 * time.Hour)
+	fourth, err := generator.Next()
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if expected := "2020-04-01T15:00:00.000000000Z_0000_"; fourth.String()[:len(expected)] != expected {
+		t.Errorf("Expected the counter to reset when the clock advances. Got '%s'", fourth)
+	}
+}
@@ -0,0 +1,226 @@
+package dynamocity
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// element is implemented by the dynamocity types which can be collected in a dynamocity.Set or dynamocity.List
+type element[T any] interface {
+	Comparable[T]
+	fmt.Stringer
+	IsZero() bool
+}
+
+// Set represents a de-duplicated collection of a dynamocity type, which is marshalled as a DynamoDB String Set.
+//
+// Set is marshalled with the canonical fixed precision of T, ordered by Compare, ignoring zero values and any value which
+// is equal to a previous value at the precision of T. An empty Set is marshalled as a NULL AttributeValue, as DynamoDB
+// does not permit an empty String Set. Set can unmarshal a String Set, or a List of strings such as a marshalled []T,
+// and is always sorted once unmarshalled.
+type Set[T element[T]] []T
+
+// NanoTimeSet is a dynamocity.Set of dynamocity.NanoTime
+type NanoTimeSet = Set[NanoTime]
+
+// MicrosTimeSet is a dynamocity.Set of dynamocity.MicrosTime
+type MicrosTimeSet = Set[MicrosTime]
+
+// MillisTimeSet is a dynamocity.Set of dynamocity.MillisTime
+type MillisTimeSet = Set[MillisTime]
+
+// SecondsTimeSet is a dynamocity.Set of dynamocity.SecondsTime
+type SecondsTimeSet = Set[SecondsTime]
+
+// DateSet is a dynamocity.Set of dynamocity.Date
+type DateSet = Set[Date]
+
+// List represents a chronologically ordered collection of a dynamocity type, which is marshalled as a DynamoDB List.
+//
+// Unlike a dynamocity.Set, a List keeps duplicate and zero values. List is sorted by Compare when marshalled and
+// unmarshalled, keeping the relative order of equal values.
+type List[T element[T]] []T
+
+// NanoTimeList is a dynamocity.List of dynamocity.NanoTime
+type NanoTimeList = List[NanoTime]
+
+// MicrosTimeList is a dynamocity.List of dynamocity.MicrosTime
+type MicrosTimeList = List[MicrosTime]
+
+// MillisTimeList is a dynamocity.List of dynamocity.MillisTime
+type MillisTimeList = List[MillisTime]
+
+// SecondsTimeList is a dynamocity.List of dynamocity.SecondsTime
+type SecondsTimeList = List[SecondsTime]
+
+// DateList is a dynamocity.List of dynamocity.Date
+type DateList = List[Date]
+
+// NewSet is a factory function for creating a dynamocity.Set in canonical order from the supplied values
+func NewSet[T element[T]](values ...T) Set[T] {
+	return Set[T](values).canonical()
+}
+
+// canonical is a helper function to return a copy of a dynamocity.Set which is sorted, without zero or duplicate values
+func (s Set[T]) canonical() Set[T] {
+	values := slices.DeleteFunc(slices.Clone(s), func(v T) bool { return v.IsZero() })
+	slices.SortStableFunc(values, func(a, b T) int { return a.Compare(b) })
+	return slices.CompactFunc(values, func(a, b T) bool { return a.Compare(b) == 0 })
+}
+
+// Contains reports whether the dynamocity.Set contains a value equal to v at the precision of T
+func (s Set[T]) Contains(v T) bool {
+	return slices.ContainsFunc(s, func(e T) bool { return e.Compare(v) == 0 })
+}
+
+// Add returns a dynamocity.Set containing the values of this dynamocity.Set and the supplied values
+func (s Set[T]) Add(values ...T) Set[T] {
+	return append(slices.Clone(s), values...).canonical()
+}
+
+// Union returns a dynamocity.Set containing the values which are in either this or the other dynamocity.Set
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	return s.Add(other...)
+}
+
+// Intersect returns a dynamocity.Set containing the values which are in both this and the other dynamocity.Set
+func (s Set[T]) Intersect(other Set[T]) Set[T] {
+	return slices.DeleteFunc(s.canonical(), func(v T) bool { return !other.Contains(v) })
+}
+
+// Difference returns a dynamocity.Set containing the values which are in this dynamocity.Set, but not the other
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	return slices.DeleteFunc(s.canonical(), func(v T) bool { return other.Contains(v) })
+}
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal a dynamocity.Set into a
+// DynamoDB String Set. An empty dynamocity.Set is marshalled as a NULL AttributeValue
+func (s Set[T]) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	values := s.canonical()
+	if len(values) == 0 {
+		return nullAttributeValue(), nil
+	}
+	ss := make([]string, len(values))
+	for i, v := range values {
+		ss[i] = v.String()
+	}
+	return &types.AttributeValueMemberSS{
+		Value: ss,
+	}, nil
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal a String Set or a
+// List into a sorted dynamocity.Set. A NULL AttributeValue is unmarshalled as an empty dynamocity.Set
+func (s *Set[T]) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if isNull(av) {
+		*s = nil
+		return nil
+	}
+	var values []T
+	switch tv := av.(type) {
+	case *types.AttributeValueMemberSS:
+		values = make([]T, len(tv.Value))
+		for i, str := range tv.Value {
+			if err := attributevalue.Unmarshal(&types.AttributeValueMemberS{Value: str}, &values[i]); err != nil {
+				return err
+			}
+		}
+	case *types.AttributeValueMemberL:
+		if err := attributevalue.Unmarshal(tv, &values); err != nil {
+			return err
+		}
+	default:
+		return &attributevalue.UnmarshalTypeError{
+			Value: fmt.Sprintf("%T", av),
+			Type:  reflect.TypeOf((*Set[T])(nil)),
+		}
+	}
+	*s = NewSet(values...)
+	return nil
+}
+
+// IsZero reports whether this dynamocity.Set has no values, which allows an empty dynamocity.Set to be omitted
+func (s Set[T]) IsZero() bool {
+	return len(s.canonical()) == 0
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal a JSON array into a sorted dynamocity.Set
+func (s *Set[T]) UnmarshalJSON(b []byte) error {
+	var values []T
+	if err := json.Unmarshal(b, &values); err != nil {
+		return err
+	}
+	*s = NewSet(values...)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal a dynamocity.Set as a JSON array in canonical order
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	values := s.canonical()
+	if values == nil {
+		values = Set[T]{}
+	}
+	return json.Marshal([]T(values))
+}
+
+// sorted is a helper function to return a copy of a dynamocity.List which is sorted, keeping the order of equal values
+func (l List[T]) sorted() List[T] {
+	values := slices.Clone(l)
+	slices.SortStableFunc(values, func(a, b T) int { return a.Compare(b) })
+	return values
+}
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal a dynamocity.List into a
+// chronologically ordered DynamoDB List
+func (l List[T]) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	values := l.sorted()
+	if values == nil {
+		values = List[T]{}
+	}
+	return attributevalue.Marshal([]T(values))
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal a List into a
+// chronologically ordered dynamocity.List. A NULL AttributeValue is unmarshalled as an empty dynamocity.List
+func (l *List[T]) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if isNull(av) {
+		*l = nil
+		return nil
+	}
+	if _, ok := av.(*types.AttributeValueMemberL); !ok {
+		return &attributevalue.UnmarshalTypeError{
+			Value: fmt.Sprintf("%T", av),
+			Type:  reflect.TypeOf((*List[T])(nil)),
+		}
+	}
+	var values []T
+	if err := attributevalue.Unmarshal(av, &values); err != nil {
+		return err
+	}
+	*l = List[T](values).sorted()
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal a JSON array into a chronologically ordered dynamocity.List
+func (l *List[T]) UnmarshalJSON(b []byte) error {
+	var values []T
+	if err := json.Unmarshal(b, &values); err != nil {
+		return err
+	}
+	*l = List[T](values).sorted()
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal a dynamocity.List as a chronologically ordered JSON array
+func (l List[T]) MarshalJSON() ([]byte, error) {
+	values := l.sorted()
+	if values == nil {
+		values = List[T]{}
+	}
+	return json.Marshal([]T(values))
+}
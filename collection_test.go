@@ -0,0 +1,302 @@
+package dynamocity_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/edwardsmatt/dynamocity"
+)
+
+func Test_SetMarshalling(t *testing.T) {
+	type TestType struct {
+		OccursAt dynamocity.MillisTimeSet `dynamodbav:"occursAt" json:"occursAt"`
+		Dates    dynamocity.DateSet       `dynamodbav:"dates,omitempty" json:"dates"`
+	}
+
+	later := time.Date(2020, time.April, 2, 14, 0, 0, 0, time.UTC)
+	earlier := time.Date(2020, time.April, 1, 14, 0, 0, 0, time.FixedZone("AEST", 10*60*60))
+
+	testCase := TestType{
+		OccursAt: dynamocity.MillisTimeSet{
+			dynamocity.MillisTime(later),
+			dynamocity.MillisTime(earlier),
+			dynamocity.MillisTime(later.Add(100 * time.Microsecond)),
+			dynamocity.MillisTime{},
+		},
+	}
+
+	item, err := attributevalue.MarshalMap(testCase)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	ss, ok := item["occursAt"].(*types.AttributeValueMemberSS)
+	if !ok {
+		t.Errorf("Expected a String Set. Got '%T'", item["occursAt"])
+		t.FailNow()
+	}
+	expected := "2020-04-01T04:00:00.000Z,2020-04-02T14:00:00.000Z"
+	if actual := strings.Join(ss.Value, ","); actual != expected {
+		t.Errorf("Expected a de-duplicated and sorted String Set. Expected '%s', Got '%s'", expected, actual)
+	}
+	if _, ok := item["dates"]; ok {
+		t.Errorf("Expected an empty DateSet to be omitted. Got '%v'", item["dates"])
+	}
+
+	item["occursAt"] = &types.AttributeValueMemberSS{Value: []string{"2020-04-02T14:00:00.000Z", "2020-04-01T04:00:00.000Z"}}
+	item["dates"] = &types.AttributeValueMemberL{Value: []types.AttributeValue{
+		&types.AttributeValueMemberS{Value: "2020-04-02"},
+		&types.AttributeValueMemberS{Value: "2020-04-01"},
+		&types.AttributeValueMemberS{Value: "2020-04-02"},
+	}}
+	var fromDynamo TestType
+	if err := attributevalue.UnmarshalMap(item, &fromDynamo); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if len(fromDynamo.OccursAt) != 2 || fromDynamo.OccursAt[0].String() != "2020-04-01T04:00:00.000Z" {
+		t.Errorf("Expected the String Set to be sorted on unmarshal. Got '%v'", fromDynamo.OccursAt)
+	}
+	if len(fromDynamo.Dates) != 2 || fromDynamo.Dates[0].String() != "2020-04-01" {
+		t.Errorf("Expected a List to be de-duplicated and sorted on unmarshal. Got '%v'", fromDynamo.Dates)
+	}
+
+	jsonBytes, err := json.Marshal(fromDynamo)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	expectedJSON := `{"occursAt":["2020-04-01T04:00:00.000Z","2020-04-02T14:00:00.000Z"],"dates":["2020-04-01","2020-04-02"]}`
+	if string(jsonBytes) != expectedJSON {
+		t.Errorf("Unexpected JSON. Expected '%s', Got '%s'", expectedJSON, jsonBytes)
+	}
+
+	var invalid dynamocity.DateSet
+	if err := invalid.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberS{Value: "2020-04-01"}); err == nil {
+		t.Errorf("Expected an error unmarshalling a string into a DateSet")
+	}
+}
+
+func Test_SetOperations(t *testing.T) {
+	day := func(d int) dynamocity.Date {
+		return dynamocity.Date(time.Date(2020, time.April, d, 0, 0, 0, 0, time.UTC))
+	}
+	join := func(s dynamocity.DateSet) string {
+		values := make([]string, len(s))
+		for i, v := range s {
+			values[i] = v.String()
+		}
+		return strings.Join(values, ",")
+	}
+
+	a := dynamocity.NewSet(day(3), day(1), day(2), day(1))
+	b := dynamocity.NewSet(day(4), day(2), day(3))
+
+	cases := []struct {
+		name     string
+		actual   dynamocity.DateSet
+		expected string
+	}{
+		{name: "Given NewSet, then sort and de-duplicate", actual: a, expected: "2020-04-01,2020-04-02,2020-04-03"},
+		{name: "Given Union, then include values of either set", actual: a.Union(b), expected: "2020-04-01,2020-04-02,2020-04-03,2020-04-04"},
+		{name: "Given Intersect, then include values of both sets", actual: a.Intersect(b), expected: "2020-04-02,2020-04-03"},
+		{name: "Given Difference, then exclude values of the other set", actual: a.Difference(b), expected: "2020-04-01"},
+		{name: "Given Add, then include the added values", actual: a.Add(day(5), day(1)), expected: "2020-04-01,2020-04-02,2020-04-03,2020-04-05"},
+	}
+
+	for _, tc := range cases {
+		if actual := join(tc.actual); actual != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, actual)
+		}
+	}
+
+	if !a.Contains(day(2)) || a.Contains(day(4)) {
+		t.Errorf("Unexpected Contains result for '%s'", join(a))
+	}
+	if join(a) != "2020-04-01,2020-04-02,2020-04-03" {
+		t.Errorf("Expected set operations not to modify the receiver. Got '%s'", join(a))
+	}
+}
+
+func Test_ListMarshalling(t *testing.T) {
+	type TestType struct {
+		AuditedAt dynamocity.MillisTimeList `dynamodbav:"auditedAt" json:"auditedAt"`
+	}
+
+	at := func(hour int) dynamocity.MillisTime {
+		return dynamocity.MillisTime(time.Date(2020, time.April, 1, hour, 0, 0, 0, time.UTC))
+	}
+
+	testCase := TestType{AuditedAt: dynamocity.MillisTimeList{at(15), at(14), at(15)}}
+
+	item, err := attributevalue.MarshalMap(testCase)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	list, ok := item["auditedAt"].(*types.AttributeValueMemberL)
+	if !ok {
+		t.Errorf("Expected a List. Got '%T'", item["auditedAt"])
+		t.FailNow()
+	}
+	expected := []string{"2020-04-01T14:00:00.000Z", "2020-04-01T15:00:00.000Z", "2020-04-01T15:00:00.000Z"}
+	if len(list.Value) != len(expected) {
+		t.Errorf("Expected duplicates to be kept. Got '%d' values", len(list.Value))
+		t.FailNow()
+	}
+	for i, av := range list.Value {
+		if actual := decodeAttributeValue(av, t); actual != expected[i] {
+			t.Errorf("Expected chronological order at index %d. Expected '%s', Got '%s'", i, expected[i], actual)
+		}
+	}
+
+	var fromJSON TestType
+	if err := json.Unmarshal([]byte(`{"auditedAt":["2020-04-01T15:00:00.000Z","2020-04-01T14:00:00.000Z"]}`), &fromJSON); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if len(fromJSON.AuditedAt) != 2 || fromJSON.AuditedAt[0].String() != "2020-04-01T14:00:00.000Z" {
+		t.Errorf("Expected the List to be sorted on unmarshal. Got '%v'", fromJSON.AuditedAt)
+	}
+}
+
+func Test_SetUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		name        string
+		input       string
+		expected    string
+		expectedErr bool
+	}{
+		{name: "Given a JSON null, then unmarshal an empty set", input: `null`, expected: ""},
+		{name: "Given an empty JSON array, then unmarshal an empty set", input: `[]`, expected: ""},
+		{name: "Given an unsorted JSON array with duplicates, then de-duplicate and sort", input: `["2020-04-02","2020-04-01","2020-04-02"]`, expected: "2020-04-01,2020-04-02"},
+		{name: "Given a JSON string, then error", input: `"2020-04-01"`, expectedErr: true},
+		{name: "Given an invalid date, then error", input: `["2020-04-31"]`, expectedErr: true},
+	}
+
+	for _, tc := range cases {
+		var set dynamocity.DateSet
+		err := set.UnmarshalJSON([]byte(tc.input))
+		if tc.expectedErr {
+			if err == nil {
+				t.Errorf("%s. Expected an error, Got '%v'", tc.name, set)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		values := make([]string, len(set))
+		for i, v := range set {
+			values[i] = v.String()
+		}
+		if actual := strings.Join(values, ","); actual != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, actual)
+		}
+		if set.IsZero() != (tc.expected == "") {
+			t.Errorf("%s. Expected IsZero '%t', Got '%t'", tc.name, tc.expected == "", set.IsZero())
+		}
+
+		jsonBytes, err := json.Marshal(set)
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		var roundTripped dynamocity.DateSet
+		if err := json.Unmarshal(jsonBytes, &roundTripped); err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if len(roundTripped) != len(set) {
+			t.Errorf("%s. Expected '%v' after JSON round trip, Got '%v'", tc.name, set, roundTripped)
+		}
+	}
+}
+
+func Test_SetIsZero(t *testing.T) {
+	cases := []struct {
+		name     string
+		set      dynamocity.DateSet
+		expected bool
+	}{
+		{name: "Given a nil set, then is zero", set: nil, expected: true},
+		{name: "Given an empty set, then is zero", set: dynamocity.DateSet{}, expected: true},
+		{name: "Given a set of only zero values, then is zero", set: dynamocity.DateSet{{}, {}}, expected: true},
+		{name: "Given a set with a value, then is not zero", set: dynamocity.NewSet(dynamocity.NewDate(2020, time.April, 1)), expected: false},
+		{name: "Given an unsorted set with a zero value, then is not zero", set: dynamocity.DateSet{dynamocity.NewDate(2020, time.April, 2), {}}, expected: false},
+	}
+
+	for _, tc := range cases {
+		if actual := tc.set.IsZero(); actual != tc.expected {
+			t.Errorf("%s. Expected '%t', Got '%t'", tc.name, tc.expected, actual)
+		}
+	}
+}
+
+func Test_ListUnmarshalDynamoDB(t *testing.T) {
+	cases := []struct {
+		name        string
+		input       types.AttributeValue
+		expected    []string
+		expectedErr bool
+	}{
+		{name: "Given a NULL attribute value, then unmarshal an empty list", input: &types.AttributeValueMemberNULL{Value: true}, expected: []string{}},
+		{name: "Given an empty List, then unmarshal an empty list", input: &types.AttributeValueMemberL{Value: []types.AttributeValue{}}, expected: []string{}},
+		{
+			name: "Given an unsorted List with duplicates, then sort and keep duplicates",
+			input: &types.AttributeValueMemberL{Value: []types.AttributeValue{
+				&types.AttributeValueMemberS{Value: "2020-04-01T15:00:00.000Z"},
+				&types.AttributeValueMemberS{Value: "2020-04-01T14:00:00.000Z"},
+				&types.AttributeValueMemberS{Value: "2020-04-01T15:00:00.000Z"},
+				&types.AttributeValueMemberS{Value: "2020-03-31T23:00:00.000Z"},
+			}},
+			expected: []string{"2020-03-31T23:00:00.000Z", "2020-04-01T14:00:00.000Z", "2020-04-01T15:00:00.000Z", "2020-04-01T15:00:00.000Z"},
+		},
+		{name: "Given a String Set, then error", input: &types.AttributeValueMemberSS{Value: []string{"2020-04-01T14:00:00.000Z"}}, expectedErr: true},
+		{name: "Given a List with an invalid timestamp, then error", input: &types.AttributeValueMemberL{Value: []types.AttributeValue{&types.AttributeValueMemberS{Value: "yesterday"}}}, expectedErr: true},
+	}
+
+	for _, tc := range cases {
+		list := dynamocity.MillisTimeList{dynamocity.MillisTime(time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC))}
+		err := list.UnmarshalDynamoDBAttributeValue(tc.input)
+		if tc.expectedErr {
+			if err == nil {
+				t.Errorf("%s. Expected an error, Got '%v'", tc.name, list)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if len(list) != len(tc.expected) {
+			t.Errorf("%s. Expected '%d' values, Got '%d'", tc.name, len(tc.expected), len(list))
+			continue
+		}
+		for i, v := range list {
+			if v.String() != tc.expected[i] {
+				t.Errorf("%s. Expected '%s' at index %d, Got '%s'", tc.name, tc.expected[i], i, v)
+			}
+		}
+
+		av, err := list.MarshalDynamoDBAttributeValue()
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		var roundTripped dynamocity.MillisTimeList
+		if err := roundTripped.UnmarshalDynamoDBAttributeValue(av); err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if len(roundTripped) != len(list) {
+			t.Errorf("%s. Expected '%v' after attribute value round trip, Got '%v'", tc.name, list, roundTripped)
+		}
+	}
+}
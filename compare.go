@@ -0,0 +1,223 @@
+package dynamocity
+
+import (
+	"cmp"
+	"time"
+)
+
+// Compare compares this dynamocity.Time with u, each truncated to the Resolution of P, so that two values which differ
+// only below the precision of P compare equal. The result is -1, 0 or +1 if this is before, equal to or after u
+func (t Time[P]) Compare(u Time[P]) int {
+	return t.Truncate().Time().Compare(u.Truncate().Time())
+}
+
+// Before reports whether this dynamocity.Time is before u, at the precision of P
+func (t Time[P]) Before(u Time[P]) bool {
+	return t.Compare(u) < 0
+}
+
+// After reports whether this dynamocity.Time is after u, at the precision of P
+func (t Time[P]) After(u Time[P]) bool {
+	return t.Compare(u) > 0
+}
+
+// Equal reports whether this dynamocity.Time and u represent the same instant, at the precision of P
+func (t Time[P]) Equal(u Time[P]) bool {
+	return t.Compare(u) == 0
+}
+
+// Compare compares this dynamocity.ReverseTime with u in the order of their marshalled strings, which is the reverse
+// of the chronological order at the precision of P. The result is -1 if this is after u, 0 if equal, or +1 if this is
+// before u. Use Forward to compare chronologically
+func (t ReverseTime[P]) Compare(u ReverseTime[P]) int {
+	return u.Forward().Compare(t.Forward())
+}
+
+// Compare compares the calendar date of this dynamocity.Date with u, ignoring the time of day
+func (t Date) Compare(u Date) int {
+	ty, tm, td := t.Time().Date()
+	uy, um, ud := u.Time().Date()
+	return compareFields([]int{ty, int(tm), td}, []int{uy, int(um), ud})
+}
+
+// Before reports whether the calendar date of this dynamocity.Date is before u
+func (t Date) Before(u Date) bool {
+	return t.Compare(u) < 0
+}
+
+// After reports whether the calendar date of this dynamocity.Date is after u
+func (t Date) After(u Date) bool {
+	return t.Compare(u) > 0
+}
+
+// Equal reports whether this dynamocity.Date and u are the same calendar date
+func (t Date) Equal(u Date) bool {
+	return t.Compare(u) == 0
+}
+
+// Compare compares the calendar month of this dynamocity.YearMonth with u
+func (t YearMonth) Compare(u YearMonth) int {
+	return compareFields([]int{t.Time().Year(), int(t.Time().Month())}, []int{u.Time().Year(), int(u.Time().Month())})
+}
+
+// Before reports whether the calendar month of this dynamocity.YearMonth is before u
+func (t YearMonth) Before(u YearMonth) bool {
+	return t.Compare(u) < 0
+}
+
+// After reports whether the calendar month of this dynamocity.YearMonth is after u
+func (t YearMonth) After(u YearMonth) bool {
+	return t.Compare(u) > 0
+}
+
+// Equal reports whether this dynamocity.YearMonth and u are the same calendar month
+func (t YearMonth) Equal(u YearMonth) bool {
+	return t.Compare(u) == 0
+}
+
+// Compare compares the calendar year of this dynamocity.Year with u
+func (t Year) Compare(u Year) int {
+	return cmp.Compare(t.Time().Year(), u.Time().Year())
+}
+
+// Before reports whether the calendar year of this dynamocity.Year is before u
+func (t Year) Before(u Year) bool {
+	return t.Compare(u) < 0
+}
+
+// After reports whether the calendar year of this dynamocity.Year is after u
+func (t Year) After(u Year) bool {
+	return t.Compare(u) > 0
+}
+
+// Equal reports whether this dynamocity.Year and u are the same calendar year
+func (t Year) Equal(u Year) bool {
+	return t.Compare(u) == 0
+}
+
+// Compare compares the ISO 8601 week of this dynamocity.ISOWeek with u
+func (t ISOWeek) Compare(u ISOWeek) int {
+	ty, tw := t.Time().ISOWeek()
+	uy, uw := u.Time().ISOWeek()
+	return compareFields([]int{ty, tw}, []int{uy, uw})
+}
+
+// Before reports whether the ISO 8601 week of this dynamocity.ISOWeek is before u
+func (t ISOWeek) Before(u ISOWeek) bool {
+	return t.Compare(u) < 0
+}
+
+// After reports whether the ISO 8601 week of this dynamocity.ISOWeek is after u
+func (t ISOWeek) After(u ISOWeek) bool {
+	return t.Compare(u) > 0
+}
+
+// Equal reports whether this dynamocity.ISOWeek and u are the same ISO 8601 week
+func (t ISOWeek) Equal(u ISOWeek) bool {
+	return t.Compare(u) == 0
+}
+
+// Compare compares the calendar quarter of this dynamocity.Quarter with u
+func (t Quarter) Compare(u Quarter) int {
+	return compareFields([]int{t.Time().Year(), t.Number()}, []int{u.Time().Year(), u.Number()})
+}
+
+// Before reports whether the calendar quarter of this dynamocity.Quarter is before u
+func (t Quarter) Before(u Quarter) bool {
+	return t.Compare(u) < 0
+}
+
+// After reports whether the calendar quarter of this dynamocity.Quarter is after u
+func (t Quarter) After(u Quarter) bool {
+	return t.Compare(u) > 0
+}
+
+// Equal reports whether this dynamocity.Quarter and u are the same calendar quarter
+func (t Quarter) Equal(u Quarter) bool {
+	return t.Compare(u) == 0
+}
+
+// Compare compares this dynamocity.TimeOfDay with u at the millisecond precision of its marshalled string
+func (t TimeOfDay) Compare(u TimeOfDay) int {
+	return cmp.Compare(wrapTimeOfDay(t.Duration()).Duration().Truncate(time.Millisecond), wrapTimeOfDay(u.Duration()).Duration().Truncate(time.Millisecond))
+}
+
+// Compare compares this dynamocity.Duration with u
+func (d Duration) Compare(u Duration) int {
+	return cmp.Compare(d, u)
+}
+
+// Compare compares this dynamocity.ISODuration with u
+func (d ISODuration) Compare(u ISODuration) int {
+	return cmp.Compare(d, u)
+}
+
+// Compare compares this dynamocity.EpochSeconds with u at second precision
+func (t EpochSeconds) Compare(u EpochSeconds) int {
+	return cmp.Compare(t.Time().Unix(), u.Time().Unix())
+}
+
+// Before reports whether this dynamocity.EpochSeconds is before u, at second precision
+func (t EpochSeconds) Before(u EpochSeconds) bool {
+	return t.Compare(u) < 0
+}
+
+// After reports whether this dynamocity.EpochSeconds is after u, at second precision
+func (t EpochSeconds) After(u EpochSeconds) bool {
+	return t.Compare(u) > 0
+}
+
+// Equal reports whether this dynamocity.EpochSeconds and u represent the same instant, at second precision
+func (t EpochSeconds) Equal(u EpochSeconds) bool {
+	return t.Compare(u) == 0
+}
+
+// Compare compares this dynamocity.EpochMillis with u at millisecond precision
+func (t EpochMillis) Compare(u EpochMillis) int {
+	return cmp.Compare(t.Time().UnixMilli(), u.Time().UnixMilli())
+}
+
+// Before reports whether this dynamocity.EpochMillis is before u, at millisecond precision
+func (t EpochMillis) Before(u EpochMillis) bool {
+	return t.Compare(u) < 0
+}
+
+// After reports whether this dynamocity.EpochMillis is after u, at millisecond precision
+func (t EpochMillis) After(u EpochMillis) bool {
+	return t.Compare(u) > 0
+}
+
+// Equal reports whether this dynamocity.EpochMillis and u represent the same instant, at millisecond precision
+func (t EpochMillis) Equal(u EpochMillis) bool {
+	return t.Compare(u) == 0
+}
+
+// Compare compares this dynamocity.EpochNanos with u
+func (t EpochNanos) Compare(u EpochNanos) int {
+	return t.Time().Compare(u.Time())
+}
+
+// Before reports whether this dynamocity.EpochNanos is before u
+func (t EpochNanos) Before(u EpochNanos) bool {
+	return t.Compare(u) < 0
+}
+
+// After reports whether this dynamocity.EpochNanos is after u
+func (t EpochNanos) After(u EpochNanos) bool {
+	return t.Compare(u) > 0
+}
+
+// Equal reports whether this dynamocity.EpochNanos and u represent the same instant
+func (t EpochNanos) Equal(u EpochNanos) bool {
+	return t.Compare(u) == 0
+}
+
+// compareFields is a helper function to compare two equal length slices of fields in order of significance
+func compareFields(a, b []int) int {
+	for i := range a {
+		if c := cmp.Compare(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
@@ -0,0 +1,257 @@
+package dynamocity_test
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edwardsmatt/dynamocity"
+)
+
+func Test_ComparePrecisionAware(t *testing.T) {
+	base := time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		compare  func() int
+		expected int
+	}{
+		{
+			name: "Given two MillisTime values which differ below a millisecond, then compare equal",
+			compare: func() int {
+				return dynamocity.MillisTime(base).Compare(dynamocity.MillisTime(base.Add(999 * time.Microsecond)))
+			},
+			expected: 0,
+		},
+		{
+			name:     "Given two NanoTime values which differ by a nanosecond, then compare before",
+			compare:  func() int { return dynamocity.NanoTime(base).Compare(dynamocity.NanoTime(base.Add(time.Nanosecond))) },
+			expected: -1,
+		},
+		{
+			name: "Given two SecondsTime values in different locations, then compare the instant",
+			compare: func() int {
+				return dynamocity.SecondsTime(base.In(time.FixedZone("AEST", 10*60*60))).Compare(dynamocity.SecondsTime(base))
+			},
+			expected: 0,
+		},
+		{
+			name:     "Given two Dates on the same day, then compare equal regardless of the time of day",
+			compare:  func() int { return dynamocity.Date(base).Compare(dynamocity.Date(base.Add(9 * time.Hour))) },
+			expected: 0,
+		},
+		{
+			name: "Given two ReverseMillisTime values, then compare in reverse chronological order",
+			compare: func() int {
+				return dynamocity.ReverseMillisTime(base).Compare(dynamocity.ReverseMillisTime(base.Add(time.Second)))
+			},
+			expected: 1,
+		},
+		{
+			name: "Given two ISOWeeks spanning a year boundary, then compare by the ISO week-numbering year",
+			compare: func() int {
+				return dynamocity.ISOWeek(time.Date(2020, time.December, 31, 0, 0, 0, 0, time.UTC)).Compare(dynamocity.ISOWeek(time.Date(2021, time.January, 3, 0, 0, 0, 0, time.UTC)))
+			},
+			expected: 0,
+		},
+		{
+			name: "Given two EpochSeconds values which differ below a second, then compare equal",
+			compare: func() int {
+				return dynamocity.EpochSeconds(base).Compare(dynamocity.EpochSeconds(base.Add(500 * time.Millisecond)))
+			},
+			expected: 0,
+		},
+		{
+			name:     "Given two TimeOfDay values which differ below a millisecond, then compare equal",
+			compare:  func() int { return dynamocity.NewTimeOfDay(9, 0, 0, 1).Compare(dynamocity.NewTimeOfDay(9, 0, 0, 2)) },
+			expected: 0,
+		},
+		{
+			name:     "Given a negative and positive Duration, then compare before",
+			compare:  func() int { return dynamocity.Duration(-time.Hour).Compare(dynamocity.Duration(time.Hour)) },
+			expected: -1,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := tc.compare(); actual != tc.expected {
+			t.Errorf("%s. Expected '%d', Got '%d'", tc.name, tc.expected, actual)
+		}
+	}
+
+	millisTime := dynamocity.MillisTime(base)
+	later := dynamocity.MillisTime(base.Add(time.Millisecond))
+	if !millisTime.Before(later) || !later.After(millisTime) || !millisTime.Equal(dynamocity.MillisTime(base.Add(time.Microsecond))) {
+		t.Errorf("Unexpected Before, After or Equal for dynamocity.MillisTime")
+	}
+}
+
+func Test_CompareAgreesWithStringOrder(t *testing.T) {
+	random := rand.New(rand.NewSource(1585749600))
+	base := time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC)
+
+	var millisTimes []dynamocity.MillisTime
+	var reverseTimes []dynamocity.ReverseMillisTime
+	var dates []dynamocity.Date
+	for i := 0; i < 100; i++ {
+		offset := time.Duration(random.Int63n(int64(30*24*time.Hour))) - 15*24*time.Hour
+		millisTimes = append(millisTimes, dynamocity.MillisTime(base.Add(offset)))
+		reverseTimes = append(reverseTimes, dynamocity.ReverseMillisTime(base.Add(offset)))
+		dates = append(dates, dynamocity.Date(base.Add(offset)))
+	}
+
+	sort.Slice(millisTimes, func(i, j int) bool { return millisTimes[i].Compare(millisTimes[j]) < 0 })
+	sort.Slice(reverseTimes, func(i, j int) bool { return reverseTimes[i].Compare(reverseTimes[j]) < 0 })
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Compare(dates[j]) < 0 })
+
+	for i := 1; i < len(millisTimes); i++ {
+		if millisTimes[i-1].String() > millisTimes[i].String() {
+			t.Errorf("Expected dynamocity.MillisTime order to agree with string order. Got '%s' before '%s'", millisTimes[i-1], millisTimes[i])
+		}
+		if reverseTimes[i-1].String() > reverseTimes[i].String() {
+			t.Errorf("Expected dynamocity.ReverseMillisTime order to agree with string order. Got '%s' before '%s'", reverseTimes[i-1], reverseTimes[i])
+		}
+		if dates[i-1].String() > dates[i].String() {
+			t.Errorf("Expected dynamocity.Date order to agree with string order. Got '%s' before '%s'", dates[i-1], dates[i])
+		}
+	}
+}
+
+func Test_BetweenGeneric(t *testing.T) {
+	start := dynamocity.MillisTime(time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC))
+	end := dynamocity.MillisTime(time.Date(2020, time.April, 1, 15, 0, 0, 0, time.UTC))
+	belowPrecision := dynamocity.MillisTime(time.Date(2020, time.April, 1, 15, 0, 0, 999, time.UTC))
+
+	if !dynamocity.BetweenInclusiveOf(belowPrecision, start, end) {
+		t.Errorf("Expected a dynamocity.MillisTime equal to the end at millisecond precision to be between inclusive")
+	}
+	if dynamocity.BetweenStartIncOf(belowPrecision, start, end) {
+		t.Errorf("Expected a dynamocity.MillisTime equal to the end at millisecond precision not to be between start inclusive")
+	}
+
+	first := dynamocity.Date(time.Date(2020, time.April, 1, 23, 0, 0, 0, time.UTC))
+	last := dynamocity.Date(time.Date(2020, time.April, 30, 0, 0, 0, 0, time.UTC))
+	if !dynamocity.BetweenInclusiveOf(dynamocity.Date(time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC)), first, last) {
+		t.Errorf("Expected a dynamocity.Date on the first day to be between inclusive, regardless of the time of day")
+	}
+	if !dynamocity.BetweenExclusiveOf(dynamocity.Duration(time.Minute), dynamocity.Duration(0), dynamocity.Duration(time.Hour)) {
+		t.Errorf("Expected a dynamocity.Duration to be between exclusive")
+	}
+
+	betweens := []func(t, start, end time.Time) bool{
+		dynamocity.BetweenStartInc,
+		dynamocity.BetweenEndInc,
+		dynamocity.BetweenExclusive,
+		dynamocity.BetweenInclusive,
+	}
+	for _, between := range betweens {
+		if between(start.Time().Add(-time.Hour), start.Time(), end.Time()) {
+			t.Errorf("Expected a time.Time before the start not to be between")
+		}
+	}
+}
+
+// ordered is implemented by the dynamocity types whose Compare, Before, After and Equal agree with their string order
+type ordered[T any] interface {
+	Compare(T) int
+	Before(T) bool
+	After(T) bool
+	Equal(T) bool
+	String() string
+}
+
+type compareCase[T any] struct {
+	name string
+	a, b T
+}
+
+// assertCompareAgreesWithString is a helper function to check that Compare, Before, After and Equal of each case
+// agree with strings.Compare of the marshalled values
+func assertCompareAgreesWithString[T ordered[T]](t *testing.T, cases []compareCase[T]) {
+	t.Helper()
+	for _, tc := range cases {
+		expected := strings.Compare(tc.a.String(), tc.b.String())
+		if actual := tc.a.Compare(tc.b); actual != expected {
+			t.Errorf("%s. Expected Compare '%d', Got '%d'", tc.name, expected, actual)
+		}
+		if actual := tc.a.Before(tc.b); actual != (expected < 0) {
+			t.Errorf("%s. Expected Before '%t', Got '%t'", tc.name, expected < 0, actual)
+		}
+		if actual := tc.a.After(tc.b); actual != (expected > 0) {
+			t.Errorf("%s. Expected After '%t', Got '%t'", tc.name, expected > 0, actual)
+		}
+		if actual := tc.a.Equal(tc.b); actual != (expected == 0) {
+			t.Errorf("%s. Expected Equal '%t', Got '%t'", tc.name, expected == 0, actual)
+		}
+	}
+}
+
+func Test_CompareDate(t *testing.T) {
+	assertCompareAgreesWithString(t, []compareCase[dynamocity.Date]{
+		{name: "Given an earlier day, then compare before", a: dynamocity.NewDate(2020, time.April, 1), b: dynamocity.NewDate(2020, time.April, 2)},
+		{name: "Given a later year, then compare after", a: dynamocity.NewDate(2021, time.January, 1), b: dynamocity.NewDate(2020, time.December, 31)},
+		{name: "Given the same day at different times, then compare equal", a: dynamocity.Date(time.Date(2020, time.April, 1, 23, 0, 0, 0, time.UTC)), b: dynamocity.NewDate(2020, time.April, 1)},
+	})
+}
+
+func Test_CompareYearMonth(t *testing.T) {
+	assertCompareAgreesWithString(t, []compareCase[dynamocity.YearMonth]{
+		{name: "Given an earlier month, then compare before", a: dynamocity.YearMonth(time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC)), b: dynamocity.YearMonth(time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC))},
+		{name: "Given a later year, then compare after", a: dynamocity.YearMonth(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)), b: dynamocity.YearMonth(time.Date(2020, time.December, 1, 0, 0, 0, 0, time.UTC))},
+		{name: "Given different days of the same month, then compare equal", a: dynamocity.YearMonth(time.Date(2020, time.April, 30, 0, 0, 0, 0, time.UTC)), b: dynamocity.YearMonth(time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC))},
+	})
+}
+
+func Test_CompareYear(t *testing.T) {
+	assertCompareAgreesWithString(t, []compareCase[dynamocity.Year]{
+		{name: "Given an earlier year, then compare before", a: dynamocity.Year(time.Date(2019, time.December, 31, 0, 0, 0, 0, time.UTC)), b: dynamocity.Year(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC))},
+		{name: "Given a later year, then compare after", a: dynamocity.Year(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)), b: dynamocity.Year(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC))},
+		{name: "Given different months of the same year, then compare equal", a: dynamocity.Year(time.Date(2020, time.December, 1, 0, 0, 0, 0, time.UTC)), b: dynamocity.Year(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC))},
+	})
+}
+
+func Test_CompareISOWeek(t *testing.T) {
+	assertCompareAgreesWithString(t, []compareCase[dynamocity.ISOWeek]{
+		{name: "Given an earlier week, then compare before", a: dynamocity.ISOWeek(time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC)), b: dynamocity.ISOWeek(time.Date(2020, time.April, 8, 0, 0, 0, 0, time.UTC))},
+		{name: "Given week 53 and the next ISO year, then compare before", a: dynamocity.ISOWeek(time.Date(2020, time.December, 31, 0, 0, 0, 0, time.UTC)), b: dynamocity.ISOWeek(time.Date(2021, time.January, 4, 0, 0, 0, 0, time.UTC))},
+		{name: "Given a later week with a single digit, then compare after", a: dynamocity.ISOWeek(time.Date(2020, time.March, 2, 0, 0, 0, 0, time.UTC)), b: dynamocity.ISOWeek(time.Date(2020, time.February, 24, 0, 0, 0, 0, time.UTC))},
+		{name: "Given days of the same week across a year boundary, then compare equal", a: dynamocity.ISOWeek(time.Date(2020, time.December, 31, 0, 0, 0, 0, time.UTC)), b: dynamocity.ISOWeek(time.Date(2021, time.January, 3, 0, 0, 0, 0, time.UTC))},
+	})
+}
+
+func Test_CompareQuarter(t *testing.T) {
+	assertCompareAgreesWithString(t, []compareCase[dynamocity.Quarter]{
+		{name: "Given an earlier quarter, then compare before", a: dynamocity.Quarter(time.Date(2020, time.March, 31, 0, 0, 0, 0, time.UTC)), b: dynamocity.Quarter(time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC))},
+		{name: "Given a later year, then compare after", a: dynamocity.Quarter(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)), b: dynamocity.Quarter(time.Date(2020, time.October, 1, 0, 0, 0, 0, time.UTC))},
+		{name: "Given months of the same quarter, then compare equal", a: dynamocity.Quarter(time.Date(2020, time.June, 30, 0, 0, 0, 0, time.UTC)), b: dynamocity.Quarter(time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC))},
+	})
+}
+
+func Test_CompareEpochSeconds(t *testing.T) {
+	base := time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC)
+	assertCompareAgreesWithString(t, []compareCase[dynamocity.EpochSeconds]{
+		{name: "Given an earlier second, then compare before", a: dynamocity.EpochSeconds(base), b: dynamocity.EpochSeconds(base.Add(time.Second))},
+		{name: "Given a later second, then compare after", a: dynamocity.EpochSeconds(base.Add(time.Hour)), b: dynamocity.EpochSeconds(base)},
+		{name: "Given a difference below a second, then compare equal", a: dynamocity.EpochSeconds(base.Add(999 * time.Millisecond)), b: dynamocity.EpochSeconds(base)},
+	})
+}
+
+func Test_CompareEpochMillis(t *testing.T) {
+	base := time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC)
+	assertCompareAgreesWithString(t, []compareCase[dynamocity.EpochMillis]{
+		{name: "Given an earlier millisecond, then compare before", a: dynamocity.EpochMillis(base), b: dynamocity.EpochMillis(base.Add(time.Millisecond))},
+		{name: "Given a later millisecond, then compare after", a: dynamocity.EpochMillis(base.Add(time.Second)), b: dynamocity.EpochMillis(base)},
+		{name: "Given a difference below a millisecond, then compare equal", a: dynamocity.EpochMillis(base.Add(999 * time.Microsecond)), b: dynamocity.EpochMillis(base)},
+	})
+}
+
+func Test_CompareEpochNanos(t *testing.T) {
+	base := time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC)
+	assertCompareAgreesWithString(t, []compareCase[dynamocity.EpochNanos]{
+		{name: "Given an earlier nanosecond, then compare before", a: dynamocity.EpochNanos(base), b: dynamocity.EpochNanos(base.Add(time.Nanosecond))},
+		{name: "Given a later nanosecond, then compare after", a: dynamocity.EpochNanos(base.Add(time.Microsecond)), b: dynamocity.EpochNanos(base)},
+		{name: "Given the same instant in another location, then compare equal", a: dynamocity.EpochNanos(base.In(time.FixedZone("AEST", 10*60*60))), b: dynamocity.EpochNanos(base)},
+	})
+}
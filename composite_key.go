@@ -0,0 +1,328 @@
+package dynamocity
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+const (
+	// KeyDelimiter separates the segments of a marshalled dynamocity.CompositeKey
+	KeyDelimiter = '#'
+	// KeyEscape escapes any byte which sorts at or below KeyEscape within a segment of a marshalled dynamocity.CompositeKey,
+	// including the KeyDelimiter. KeyEscape is the byte immediately after the KeyDelimiter, so that the KeyDelimiter sorts
+	// before every byte of an escaped segment
+	KeyEscape = '$'
+	// keyEscapeOffset is added to an escaped byte, so that it follows the KeyEscape as a letter from `@` to `d`
+	keyEscapeOffset = '@'
+)
+
+// CompositeKey represents the segments of a single table design key, such as `ORG#42#ORDER#2020-04-01T14:00:00.000Z`,
+// which is marshalled as a single DynamoDB AttributeValue string value.
+//
+// Each segment is joined by the KeyDelimiter, after escaping each byte of the segment which sorts at or below the
+// KeyEscape, such as the KeyDelimiter, a space or `!`, as the KeyEscape followed by the byte offset to a letter. For
+// example, the segment `a#b` is marshalled as `a$cb`. As a segment can never contain an unescaped KeyDelimiter, a
+// CompositeKey only begins with the Prefix of another CompositeKey if it begins with all of its segments. As the
+// KeyDelimiter sorts before every byte of an escaped segment, and escaping preserves the order of bytes, marshalled
+// keys sort in the same order as their segments compared in turn: keys which share leading segments sort by their next
+// segment, and a segment sorts before any longer segment which it prefixes.
+// A CompositeKey is built from typed segments using NewCompositeKey, and parsed back into typed segments using Scan.
+type CompositeKey []string
+
+// KeySegment is a typed segment of a dynamocity.CompositeKey, created by Literal, Text, Number or Value
+type KeySegment interface {
+	keySegment() (string, error)
+}
+
+// Literal is a KeySegment with a fixed value, such as an entity type. When scanned, a Literal must match the segment exactly
+type Literal string
+
+// keySegment implements the KeySegment interface to supply the value of the Literal
+func (l Literal) keySegment() (string, error) {
+	return string(l), nil
+}
+
+// textSegment is a KeySegment with a variable string value
+type textSegment string
+
+// keySegment implements the KeySegment interface to supply the string value
+func (s textSegment) keySegment() (string, error) {
+	return string(s), nil
+}
+
+// numberSegment is a KeySegment with a sortable number value
+type numberSegment int64
+
+// keySegment implements the KeySegment interface to supply the sortable encoding of the number
+func (n numberSegment) keySegment() (string, error) {
+	return Duration(n).String(), nil
+}
+
+// valueSegment is a KeySegment with the text value of a dynamocity type
+type valueSegment struct {
+	value encoding.TextMarshaler
+}
+
+// keySegment implements the KeySegment interface to supply the marshalled text of the value
+func (v valueSegment) keySegment() (string, error) {
+	b, err := v.value.MarshalText()
+	return string(b), err
+}
+
+// Text returns a KeySegment with a variable string value, such as an identifier, which is scanned into a *string
+func Text(s string) KeySegment {
+	return textSegment(s)
+}
+
+// Number returns a KeySegment with a number encoded with the same fixed width sortable encoding as a dynamocity.Duration,
+// for example `P0000000000000000042`, so that numbers sort numerically. A Number is scanned into an *int64
+func Number(n int64) KeySegment {
+	return numberSegment(n)
+}
+
+// Value returns a KeySegment with the marshalled text of a value, such as any of the dynamocity time types, which is
+// scanned into a pointer to the same type
+func Value(v encoding.TextMarshaler) KeySegment {
+	return valueSegment{value: v}
+}
+
+// NewCompositeKey is a factory function for creating a dynamocity.CompositeKey from the supplied typed segments
+func NewCompositeKey(segments ...KeySegment) (CompositeKey, error) {
+	key := make(CompositeKey, len(segments))
+	for i, segment := range segments {
+		value, err := segment.keySegment()
+		if err != nil {
+			return nil, fmt.Errorf("Composite Key segment %d cannot be marshalled: %w", i, err)
+		}
+		key[i] = value
+	}
+	return key, nil
+}
+
+// Append returns a dynamocity.CompositeKey with the supplied typed segments appended to the segments of this key
+func (k CompositeKey) Append(segments ...KeySegment) (CompositeKey, error) {
+	appended, err := NewCompositeKey(segments...)
+	if err != nil {
+		return nil, err
+	}
+	return append(append(CompositeKey{}, k...), appended...), nil
+}
+
+// Scan parses the segments of this dynamocity.CompositeKey into the supplied destinations, which must have the same
+// number of segments. A destination may be a Literal, which must match the segment, a *string for Text, an *int64 for
+// Number, or an encoding.TextUnmarshaler, such as a pointer to any of the dynamocity time types, for Value
+func (k CompositeKey) Scan(dest ...interface{}) error {
+	if len(dest) != len(k) {
+		return fmt.Errorf("Composite Key '%s' has %d segments, but %d destinations were supplied", k, len(k), len(dest))
+	}
+	for i, d := range dest {
+		segment := k[i]
+		switch tv := d.(type) {
+		case Literal:
+			if segment != string(tv) {
+				return fmt.Errorf("Composite Key '%s' segment %d is '%s', not the Literal '%s'", k, i, segment, tv)
+			}
+		case *string:
+			*tv = segment
+		case *int64:
+			n, ok := parseSortableDuration(segment)
+			if !ok {
+				return fmt.Errorf("Composite Key '%s' segment %d is not a Number", k, i)
+			}
+			*tv = int64(n)
+		case encoding.TextUnmarshaler:
+			if err := tv.UnmarshalText([]byte(segment)); err != nil {
+				return fmt.Errorf("Composite Key '%s' segment %d cannot be unmarshalled: %w", k, i, err)
+			}
+		default:
+			return fmt.Errorf("Composite Key segment %d cannot be scanned into '%T'", i, d)
+		}
+	}
+	return nil
+}
+
+// HasPrefix reports whether this dynamocity.CompositeKey begins with all of the segments of prefix
+func (k CompositeKey) HasPrefix(prefix CompositeKey) bool {
+	if len(prefix) > len(k) {
+		return false
+	}
+	for i, segment := range prefix {
+		if k[i] != segment {
+			return false
+		}
+	}
+	return true
+}
+
+// Prefix returns the marshalled dynamocity.CompositeKey followed by a KeyDelimiter, for use with begins_with to
+// select the keys which begin with all of its segments. For example, `ORG#42#` does not select `ORG#420#ORDER`.
+// A dynamocity.CompositeKey without segments returns an empty string, rather than a lone KeyDelimiter which no key
+// begins with
+func (k CompositeKey) Prefix() string {
+	if k.IsZero() {
+		return ""
+	}
+	return k.String() + string(KeyDelimiter)
+}
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal
+// a dynamocity.CompositeKey into a DynamoDB AttributeValue string value.
+// A dynamocity.CompositeKey without segments is marshalled as a NULL AttributeValue, and a dynamocity.CompositeKey
+// with a single empty segment returns an error
+func (k CompositeKey) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	if k.IsZero() {
+		return nullAttributeValue(), nil
+	}
+	str, err := k.marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &types.AttributeValueMemberS{
+		Value: str,
+	}, nil
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal
+// a types.AttributeValue into a dynamocity.CompositeKey. A NULL AttributeValue is unmarshalled as a dynamocity.CompositeKey without segments
+func (k *CompositeKey) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if isNull(av) {
+		*k = nil
+		return nil
+	}
+	tv, ok := av.(*types.AttributeValueMemberS)
+	if !ok {
+		return &attributevalue.UnmarshalTypeError{
+			Value: fmt.Sprintf("%T", av),
+			Type:  reflect.TypeOf((*CompositeKey)(nil)),
+		}
+	}
+	parsed, err := ParseCompositeKey(tv.Value)
+	if err != nil {
+		return err
+	}
+	*k = parsed
+	return nil
+}
+
+// IsZero reports whether this dynamocity.CompositeKey has no segments
+func (k CompositeKey) IsZero() bool {
+	return len(k) == 0
+}
+
+// marshal is a helper function to supply the String of a dynamocity.CompositeKey for marshalling. A single empty segment
+// returns an error, as it would be marshalled as an empty string which is neither a valid DynamoDB key nor distinguishable
+// from a dynamocity.CompositeKey without segments
+func (k CompositeKey) marshal() (string, error) {
+	if len(k) == 1 && k[0] == "" {
+		return "", fmt.Errorf("Composite Key with a single empty segment cannot be marshalled")
+	}
+	return k.String(), nil
+}
+
+// String implements the fmt.Stringer interface to supply the escaped segments joined by the KeyDelimiter
+func (k CompositeKey) String() string {
+	var b strings.Builder
+	for i, segment := range k {
+		if i > 0 {
+			b.WriteRune(KeyDelimiter)
+		}
+		for j := 0; j < len(segment); j++ {
+			c := segment[j]
+			if c <= KeyEscape {
+				b.WriteByte(KeyEscape)
+				c += keyEscapeOffset
+			}
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal a marshalled dynamocity.CompositeKey. A JSON null is a no-op
+func (k *CompositeKey) UnmarshalJSON(b []byte) error {
+	if isJSONNull(b) {
+		return nil
+	}
+	str, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	parsed, err := ParseCompositeKey(str)
+	if err != nil {
+		return err
+	}
+	*k = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal the escaped segments joined by the KeyDelimiter.
+// A dynamocity.CompositeKey with a single empty segment returns an error
+func (k CompositeKey) MarshalJSON() ([]byte, error) {
+	str, err := k.marshal()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(strconv.Quote(str)), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface to unmarshal a marshalled dynamocity.CompositeKey
+func (k *CompositeKey) UnmarshalText(b []byte) error {
+	parsed, err := ParseCompositeKey(string(b))
+	if err != nil {
+		return err
+	}
+	*k = parsed
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface to marshal the escaped segments joined by the KeyDelimiter.
+// A dynamocity.CompositeKey with a single empty segment returns an error
+func (k CompositeKey) MarshalText() ([]byte, error) {
+	str, err := k.marshal()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(str), nil
+}
+
+// ParseCompositeKey will attempt to split a marshalled dynamocity.CompositeKey into its unescaped segments. An empty
+// string is parsed as a dynamocity.CompositeKey without segments, and a byte which sorts at or below the KeyEscape must
+// be escaped
+func ParseCompositeKey(str string) (CompositeKey, error) {
+	if str == "" {
+		return nil, nil
+	}
+	var key CompositeKey
+	var segment strings.Builder
+	escaped := false
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		switch {
+		case escaped:
+			if c < keyEscapeOffset || c > keyEscapeOffset+KeyEscape {
+				return nil, fmt.Errorf("Composite Key '%s' has an invalid escape sequence", str)
+			}
+			segment.WriteByte(c - keyEscapeOffset)
+			escaped = false
+		case c == KeyEscape:
+			escaped = true
+		case c == KeyDelimiter:
+			key = append(key, segment.String())
+			segment.Reset()
+		case c < KeyDelimiter:
+			return nil, fmt.Errorf("Composite Key '%s' has an unescaped byte '%q'", str, c)
+		default:
+			segment.WriteByte(c)
+		}
+	}
+	if escaped {
+		return nil, fmt.Errorf("Composite Key '%s' has an unterminated escape sequence", str)
+	}
+	return append(key, segment.String()), nil
+}
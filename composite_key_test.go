@@ -0,0 +1,338 @@
+package dynamocity_test
+
+import (
+	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/edwardsmatt/dynamocity"
+)
+
+func Test_CompositeKeyRoundTrip(t *testing.T) {
+	orderedAt := dynamocity.MillisTime(time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC))
+
+	cases := []struct {
+		name     string
+		segments []dynamocity.KeySegment
+		expected string
+	}{
+		{
+			name:     "Given literals, text and a time, then join with the delimiter",
+			segments: []dynamocity.KeySegment{dynamocity.Literal("ORG"), dynamocity.Text("42"), dynamocity.Literal("ORDER"), dynamocity.Value(orderedAt)},
+			expected: "ORG#42#ORDER#2020-04-01T14:00:00.000Z",
+		},
+		{
+			name:     "Given text containing bytes which sort at or below the escape, then escape them",
+			segments: []dynamocity.KeySegment{dynamocity.Literal("USER"), dynamocity.Text(`a#b$c d!\`), dynamocity.Literal("PROFILE")},
+			expected: "USER#a$cb$dc$`d$a\\#PROFILE",
+		},
+		{
+			name:     "Given numbers, then use the sortable encoding",
+			segments: []dynamocity.KeySegment{dynamocity.Number(42), dynamocity.Number(-1)},
+			expected: "P0000000000000000042#N9223372036854775807",
+		},
+		{
+			name:     "Given an empty segment, then keep it",
+			segments: []dynamocity.KeySegment{dynamocity.Literal("ORG"), dynamocity.Text(""), dynamocity.Literal("ORDER")},
+			expected: "ORG##ORDER",
+		},
+	}
+
+	type TestType struct {
+		SK dynamocity.CompositeKey `dynamodbav:"sk" json:"sk"`
+	}
+
+	for _, tc := range cases {
+		key, err := dynamocity.NewCompositeKey(tc.segments...)
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if key.String() != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, key)
+		}
+
+		item, err := attributevalue.MarshalMap(TestType{SK: key})
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if actual := decodeAttributeValue(item["sk"], t); actual != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, actual)
+		}
+		var fromDynamo TestType
+		if err := attributevalue.UnmarshalMap(item, &fromDynamo); err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if len(fromDynamo.SK) != len(key) || !fromDynamo.SK.HasPrefix(key) {
+			t.Errorf("%s. Expected segments '%q' after attribute value round trip, Got '%q'", tc.name, key, fromDynamo.SK)
+		}
+
+		jsonBytes, err := json.Marshal(TestType{SK: key})
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		var fromJSON TestType
+		if err := json.Unmarshal(jsonBytes, &fromJSON); err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if fromJSON.SK.String() != tc.expected {
+			t.Errorf("%s. Expected '%s' after JSON round trip, Got '%s'", tc.name, tc.expected, fromJSON.SK)
+		}
+	}
+}
+
+func Test_CompositeKeyScan(t *testing.T) {
+	key, err := dynamocity.ParseCompositeKey(`ORG#a$c1#ORDER#P0000000000000000007#2020-04-01T14:00:00.000Z`)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	var orgID string
+	var sequence int64
+	var orderedAt dynamocity.MillisTime
+	if err := key.Scan(dynamocity.Literal("ORG"), &orgID, dynamocity.Literal("ORDER"), &sequence, &orderedAt); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if orgID != "a#1" || sequence != 7 || orderedAt.String() != "2020-04-01T14:00:00.000Z" {
+		t.Errorf("Unexpected scanned segments. Got '%s', '%d', '%s'", orgID, sequence, orderedAt)
+	}
+
+	cases := []struct {
+		name string
+		dest []interface{}
+	}{
+		{name: "Given a mismatched Literal, then error", dest: []interface{}{dynamocity.Literal("USER"), &orgID, dynamocity.Literal("ORDER"), &sequence, &orderedAt}},
+		{name: "Given too few destinations, then error", dest: []interface{}{dynamocity.Literal("ORG"), &orgID}},
+		{name: "Given text into a Number, then error", dest: []interface{}{dynamocity.Literal("ORG"), &sequence, dynamocity.Literal("ORDER"), &sequence, &orderedAt}},
+		{name: "Given text into a time, then error", dest: []interface{}{dynamocity.Literal("ORG"), &orderedAt, dynamocity.Literal("ORDER"), &sequence, &orderedAt}},
+		{name: "Given an unsupported destination, then error", dest: []interface{}{dynamocity.Literal("ORG"), &orgID, dynamocity.Literal("ORDER"), &sequence, 42}},
+	}
+
+	for _, tc := range cases {
+		if err := key.Scan(tc.dest...); err == nil {
+			t.Errorf("%s. Expected an error", tc.name)
+		}
+	}
+
+	for _, invalid := range []string{`ORG$`, `ORG$x`, `ORG$?`, `ORG!`, "ORG SEQ"} {
+		if _, err := dynamocity.ParseCompositeKey(invalid); err == nil {
+			t.Errorf("Expected an error parsing '%s'", invalid)
+		}
+	}
+}
+
+func Test_CompositeKeyOrdering(t *testing.T) {
+	newKey := func(org string, n int64) dynamocity.CompositeKey {
+		key, err := dynamocity.NewCompositeKey(dynamocity.Literal("ORG"), dynamocity.Text(org), dynamocity.Literal("SEQ"), dynamocity.Number(n))
+		if err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+		return key
+	}
+
+	prefix, err := dynamocity.NewCompositeKey(dynamocity.Literal("ORG"), dynamocity.Text("42"))
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if prefix.Prefix() != "ORG#42#" {
+		t.Errorf("Unexpected Prefix. Got '%s'", prefix.Prefix())
+	}
+	if !newKey("42", 1).HasPrefix(prefix) || newKey("420", 1).HasPrefix(prefix) {
+		t.Errorf("Expected HasPrefix to match whole segments")
+	}
+
+	numbers := []int64{100, -5, 7, 0, -100}
+	keys := make([]string, len(numbers))
+	for i, n := range numbers {
+		keys[i] = newKey("42", n).String()
+	}
+	sort.Strings(keys)
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	for i, n := range numbers {
+		var sequence int64
+		key, err := dynamocity.ParseCompositeKey(keys[i])
+		if err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+		if err := key.Scan(dynamocity.Literal("ORG"), new(string), dynamocity.Literal("SEQ"), &sequence); err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+		if sequence != n {
+			t.Errorf("Expected string order to match numeric order at index %d. Expected '%d', Got '%d'", i, n, sequence)
+		}
+	}
+
+	adversarial := []dynamocity.CompositeKey{
+		{"USER", "a", "x"},
+		{"USER", "a!"},
+		{"USER", "a "},
+		{"USER", "a\x00"},
+		{"USER", "a#"},
+		{"USER", "a$"},
+		{"USER", "a%"},
+		{"USER", "a\\"},
+		{"USER", "a"},
+		{"USER", ""},
+		{"USER", "", "x"},
+		{"USER", "ab"},
+		{"USER", "aé"},
+		{"USER"},
+	}
+	expected := append([]dynamocity.CompositeKey{}, adversarial...)
+	slices.SortFunc(expected, func(a, b dynamocity.CompositeKey) int { return slices.Compare(a, b) })
+	marshalled := make([]string, len(adversarial))
+	for i, key := range adversarial {
+		marshalled[i] = key.String()
+	}
+	sort.Strings(marshalled)
+	for i, key := range expected {
+		if marshalled[i] != key.String() {
+			t.Errorf("Expected string order to match segment order at index %d. Expected '%s', Got '%s'", i, key, marshalled[i])
+		}
+		parsed, err := dynamocity.ParseCompositeKey(key.String())
+		if err != nil {
+			t.Errorf("Unexpected error parsing '%s'. Got '%v'", key, err)
+			continue
+		}
+		if slices.Compare(parsed, key) != 0 {
+			t.Errorf("Expected segments '%q' after parsing, Got '%q'", key, parsed)
+		}
+	}
+}
+
+func Test_CompositeKeyEmpty(t *testing.T) {
+	emptySegment := dynamocity.CompositeKey{""}
+	if _, err := attributevalue.Marshal(emptySegment); err == nil {
+		t.Errorf("Expected an error when marshalling a dynamocity.CompositeKey with a single empty segment")
+	}
+	if _, err := json.Marshal(emptySegment); err == nil {
+		t.Errorf("Expected an error when marshalling a dynamocity.CompositeKey with a single empty segment to JSON")
+	}
+	if _, err := emptySegment.MarshalText(); err == nil {
+		t.Errorf("Expected an error when marshalling a dynamocity.CompositeKey with a single empty segment to text")
+	}
+
+	if prefix := dynamocity.CompositeKey(nil).Prefix(); prefix != "" {
+		t.Errorf("Expected an empty Prefix for a dynamocity.CompositeKey without segments. Got '%s'", prefix)
+	}
+	if prefix := (dynamocity.CompositeKey{"", ""}).Prefix(); prefix != "##" {
+		t.Errorf("Expected the Prefix of empty segments to keep each KeyDelimiter. Got '%s'", prefix)
+	}
+}
+
+func Test_CompositeKeyAppend(t *testing.T) {
+	orderedAt := dynamocity.MillisTime(time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC))
+
+	base, err := dynamocity.NewCompositeKey(dynamocity.Literal("USER"), dynamocity.Text("a#b"))
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	appended, err := base.Append(dynamocity.Literal("ORDER"), dynamocity.Text("x$y"), dynamocity.Value(orderedAt))
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	expected := "USER#a$cb#ORDER#x$dy#2020-04-01T14:00:00.000Z"
+	if appended.String() != expected {
+		t.Errorf("Expected appended segments to be escaped. Expected '%s', Got '%s'", expected, appended)
+	}
+	whole, err := dynamocity.NewCompositeKey(dynamocity.Literal("USER"), dynamocity.Text("a#b"), dynamocity.Literal("ORDER"), dynamocity.Text("x$y"), dynamocity.Value(orderedAt))
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if appended.String() != whole.String() {
+		t.Errorf("Expected Append to match NewCompositeKey of every segment. Expected '%s', Got '%s'", whole, appended)
+	}
+	parsed, err := dynamocity.ParseCompositeKey(appended.String())
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if slices.Compare(parsed, appended) != 0 {
+		t.Errorf("Expected segments '%q' after parsing, Got '%q'", appended, parsed)
+	}
+
+	other, err := base.Append(dynamocity.Literal("PROFILE"))
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if base.String() != "USER#a$cb" || appended.String() != expected || other.String() != "USER#a$cb#PROFILE" {
+		t.Errorf("Expected Append not to modify the receiver or a previously appended key. Got '%s', '%s' and '%s'", base, appended, other)
+	}
+
+	if _, err := base.Append(dynamocity.Value(dynamocity.CompositeKey{""})); err == nil {
+		t.Errorf("Expected an error when appending a segment which cannot be marshalled")
+	}
+}
+
+func Test_CompositeKeyAppendOrdering(t *testing.T) {
+	prefix, err := dynamocity.NewCompositeKey(dynamocity.Literal("ORG"), dynamocity.Text("4#2"))
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	sibling, err := dynamocity.NewCompositeKey(dynamocity.Literal("ORG"), dynamocity.Text("4#20"))
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	numbers := []int64{100, -5, 7, 0, -100}
+	var keys []string
+	for _, n := range numbers {
+		key, err := prefix.Append(dynamocity.Literal("SEQ"), dynamocity.Number(n))
+		if err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+		if !strings.HasPrefix(key.String(), prefix.Prefix()) || !key.HasPrefix(prefix) {
+			t.Errorf("Expected '%s' to begin with the Prefix '%s'", key, prefix.Prefix())
+		}
+		keys = append(keys, key.String())
+
+		outside, err := sibling.Append(dynamocity.Literal("SEQ"), dynamocity.Number(n))
+		if err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+		if strings.HasPrefix(outside.String(), prefix.Prefix()) || outside.HasPrefix(prefix) {
+			t.Errorf("Expected '%s' not to begin with the Prefix '%s'", outside, prefix.Prefix())
+		}
+	}
+
+	sort.Strings(keys)
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	for i, n := range numbers {
+		key, err := dynamocity.ParseCompositeKey(keys[i])
+		if err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+		var sequence int64
+		if err := key.Scan(dynamocity.Literal("ORG"), new(string), dynamocity.Literal("SEQ"), &sequence); err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+		if sequence != n {
+			t.Errorf("Expected string order to match numeric order at index %d. Expected '%d', Got '%d'", i, n, sequence)
+		}
+	}
+}
@@ -18,23 +18,32 @@ import (
 type Date time.Time
 
 // MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal
-// a dynamocity.Date into a DynamoDB AttributeValue string value with specific second precision
+// a dynamocity.Date into a DynamoDB AttributeValue string value with specific second precision.
 func (t Date) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return t.attributeValue(), nil
+}
+
+// attributeValue is a helper function to marshal a dynamocity.Date into a string AttributeValue
+func (t Date) attributeValue() types.AttributeValue {
 	rfcTime := time.Time(t).Format(StrictDateFmt)
 	return &types.AttributeValueMemberS{
 		Value: rfcTime,
-	}, nil
+	}
 }
 
 // UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal
 // a attributevalue.AttributeValue into a dynamocity.Date. This unmarshal is flexible and supports any timestamp
-// with nanosecond precision
+// with nanosecond precision. A NULL AttributeValue is unmarshalled as a zero dynamocity.Date
 func (t *Date) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if isNull(av) {
+		*t = Date{}
+		return nil
+	}
 	tv, ok := av.(*types.AttributeValueMemberS)
 	if !ok {
 		return &attributevalue.UnmarshalTypeError{
 			Value: fmt.Sprintf("%T", av),
-			Type:  reflect.TypeOf((*MillisTime)(nil)),
+			Type:  reflect.TypeOf((*Date)(nil)),
 		}
 	}
 	date, err := parse(tv.Value)
@@ -62,14 +71,27 @@ func (t Date) Time() time.Time {
 	return time.Time(t)
 }
 
+// acceptsLegacy implements the legacyTarget interface to allow a dynamocity.LegacyDecoder to decode a dynamocity.Date
+func (t Date) acceptsLegacy() bool {
+	return true
+}
+
+// IsZero reports whether this dynamocity.Date represents the zero time instant
+func (t Date) IsZero() bool {
+	return t.Time().IsZero()
+}
+
 // String implements the fmt.Stringer interface to supply a native String representation for a value in time.RFC3339
 // Format with second precision
 func (t Date) String() string {
 	return t.Time().Format(StrictDateFmt)
 }
 
-// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal a date or RFC3339 timestamp
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal a date or RFC3339 timestamp. A JSON null is a no-op
 func (t *Date) UnmarshalJSON(b []byte) error {
+	if isJSONNull(b) {
+		return nil
+	}
 	str, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -87,8 +109,30 @@ func (t Date) MarshalJSON() ([]byte, error) {
 	return []byte(strconv.Quote(t.String())), nil
 }
 
+// UnmarshalText implements the encoding.TextUnmarshaler interface to unmarshal a date or RFC3339 timestamp
+func (t *Date) UnmarshalText(b []byte) error {
+	parsedTime, err := parse(string(b))
+	if err != nil {
+		return fmt.Errorf("Timestamp '%s' cannot be unmarshalled", string(b))
+	}
+	*t = Date(parsedTime)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface to marshal a date with the format YYYY-MM-DD
+func (t Date) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
 // ParseDate will attempt to parse any RFC3339 Timestamp or date with format YYYY-MM-DD to a dynamocity.Date
 func ParseDate(str string) (Date, error) {
 	time, err := parse(str)
 	return Date(time), err
 }
+
+// NewDate is a factory function for creating a dynamocity.Date at midnight UTC of the supplied year, month and day,
+// which is equal to the value that would be unmarshalled from its marshalled string. Values outside their usual ranges
+// are normalised in the same way as time.Date
+func NewDate(year int, month time.Month, day int) Date {
+	return Date(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
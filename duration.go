@@ -0,0 +1,378 @@
+package dynamocity
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// durationDigits is the fixed number of digits in the sortable encoding of a dynamocity.Duration
+const durationDigits = 19
+
+// Duration represents a sortable time.Duration with a fixed width encoding of a sign followed by 19 digits of nanoseconds.
+//
+// A positive or zero Duration is encoded as "P" followed by its nanoseconds; whereas a negative Duration is encoded as
+// "N" followed by its nanoseconds offset from math.MinInt64, so that the lexicographic order of the encoding is the same
+// as the numeric order. For example, 90 minutes is encoded as `P0000005400000000000` and -1ns as `N9223372036854775807`.
+//
+// A zero Duration is meaningful, so it is always marshalled as `P0000000000000000000`; use a dynamocity.NullDuration
+// where an unset duration must be distinguished. Duration can unmarshal from the sortable encoding, an ISO 8601
+// duration, a time.Duration string or a Number AttributeValue of nanoseconds, which is how a time.Duration is
+// marshalled by default.
+type Duration time.Duration
+
+// ISODuration represents a dynamocity.Duration which marshals to JSON and text as a human readable ISO 8601 duration,
+// for example `PT1H30M`. ISODuration marshals to DynamoDB with the same sortable encoding as a dynamocity.Duration.
+type ISODuration Duration
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal
+// a dynamocity.Duration into a DynamoDB AttributeValue string value with the sortable encoding
+func (d Duration) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return d.attributeValue(), nil
+}
+
+// attributeValue is a helper function to marshal a dynamocity.Duration into a string AttributeValue
+func (d Duration) attributeValue() types.AttributeValue {
+	return &types.AttributeValueMemberS{
+		Value: d.String(),
+	}
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal
+// a types.AttributeValue into a dynamocity.Duration. A Number AttributeValue is unmarshalled as nanoseconds and a
+// NULL AttributeValue is unmarshalled as a zero dynamocity.Duration
+func (d *Duration) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if isNull(av) {
+		*d = 0
+		return nil
+	}
+	switch tv := av.(type) {
+	case *types.AttributeValueMemberS:
+		parsed, err := ParseDuration(tv.Value)
+		if err != nil {
+			return err
+		}
+		*d = parsed
+		return nil
+	case *types.AttributeValueMemberN:
+		nanos, err := strconv.ParseInt(tv.Value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("Duration '%s' cannot be unmarshalled as a valid number of nanoseconds", tv.Value)
+		}
+		*d = Duration(nanos)
+		return nil
+	default:
+		return &attributevalue.UnmarshalTypeError{
+			Value: fmt.Sprintf("%T", av),
+			Type:  reflect.TypeOf((*Duration)(nil)),
+		}
+	}
+}
+
+// Duration is a handler func to return an instance of dynamocity.Duration as time.Duration
+func (d Duration) Duration() time.Duration {
+	return time.Duration(d)
+}
+
+// IsZero reports whether this dynamocity.Duration is zero
+func (d Duration) IsZero() bool {
+	return d == 0
+}
+
+// String implements the fmt.Stringer interface to supply the sortable encoding of a dynamocity.Duration
+func (d Duration) String() string {
+	if d < 0 {
+		return "N" + padDuration(int64(d)-math.MinInt64)
+	}
+	return "P" + padDuration(int64(d))
+}
+
+// ISO returns the ISO 8601 representation of a dynamocity.Duration in hours, minutes and seconds. For example: `PT1H30M`
+func (d Duration) ISO() string {
+	var sb strings.Builder
+	// The magnitude is calculated as a uint64, as math.MinInt64 cannot be negated as an int64
+	magnitude := uint64(d)
+	if d < 0 {
+		sb.WriteString("-")
+		magnitude = -magnitude
+	}
+	sb.WriteString("PT")
+
+	hours := magnitude / uint64(time.Hour)
+	minutes := magnitude % uint64(time.Hour) / uint64(time.Minute)
+	seconds := magnitude % uint64(time.Minute) / uint64(time.Second)
+	nanos := magnitude % uint64(time.Second)
+
+	if hours > 0 {
+		sb.WriteString(strconv.FormatUint(hours, 10) + "H")
+	}
+	if minutes > 0 {
+		sb.WriteString(strconv.FormatUint(minutes, 10) + "M")
+	}
+	if seconds > 0 || nanos > 0 || magnitude == 0 {
+		sb.WriteString(strconv.FormatUint(seconds, 10))
+		if nanos > 0 {
+			sb.WriteString(strings.TrimRight(fmt.Sprintf(".%09d", nanos), "0"))
+		}
+		sb.WriteString("S")
+	}
+	return sb.String()
+}
+
+// ISODuration returns this dynamocity.Duration as a dynamocity.ISODuration
+func (d Duration) ISODuration() ISODuration {
+	return ISODuration(d)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal a sortable, ISO 8601 or time.Duration string.
+// A JSON null is a no-op
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	if isJSONNull(b) {
+		return nil
+	}
+	str, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	parsed, err := ParseDuration(str)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal the sortable encoding of a dynamocity.Duration
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface to unmarshal a sortable, ISO 8601 or time.Duration string
+func (d *Duration) UnmarshalText(b []byte) error {
+	parsed, err := ParseDuration(string(b))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface to marshal the sortable encoding of a dynamocity.Duration
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// Scan implements the sql.Scanner interface to scan an integer number of nanoseconds, or a string or []byte value
+// which is parsed with the same rules as UnmarshalText, from a database driver into a dynamocity.Duration.
+// A SQL NULL is scanned as a zero dynamocity.Duration
+func (d *Duration) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*d = 0
+		return nil
+	case int64:
+		*d = Duration(v)
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case []byte:
+		return d.UnmarshalText(v)
+	default:
+		return fmt.Errorf("Value of type %T cannot be scanned into a dynamocity.Duration", src)
+	}
+}
+
+// Value implements the driver.Valuer interface to supply a dynamocity.Duration to a database driver as an integer
+// number of nanoseconds
+func (d Duration) Value() (driver.Value, error) {
+	return int64(d), nil
+}
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal
+// a dynamocity.ISODuration into a DynamoDB AttributeValue string value with the sortable encoding
+func (d ISODuration) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return d.attributeValue(), nil
+}
+
+// attributeValue is a helper function to marshal a dynamocity.ISODuration into a string AttributeValue
+func (d ISODuration) attributeValue() types.AttributeValue {
+	return Duration(d).attributeValue()
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal
+// a types.AttributeValue into a dynamocity.ISODuration, with the same rules as a dynamocity.Duration
+func (d *ISODuration) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return (*Duration)(d).UnmarshalDynamoDBAttributeValue(av)
+}
+
+// Duration is a handler func to return an instance of dynamocity.ISODuration as time.Duration
+func (d ISODuration) Duration() time.Duration {
+	return time.Duration(d)
+}
+
+// IsZero reports whether this dynamocity.ISODuration is zero
+func (d ISODuration) IsZero() bool {
+	return d == 0
+}
+
+// String implements the fmt.Stringer interface to supply the ISO 8601 representation of a dynamocity.ISODuration
+func (d ISODuration) String() string {
+	return Duration(d).ISO()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal a sortable, ISO 8601 or time.Duration string.
+// A JSON null is a no-op
+func (d *ISODuration) UnmarshalJSON(b []byte) error {
+	return (*Duration)(d).UnmarshalJSON(b)
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal the ISO 8601 representation of a dynamocity.ISODuration
+func (d ISODuration) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface to unmarshal a sortable, ISO 8601 or time.Duration string
+func (d *ISODuration) UnmarshalText(b []byte) error {
+	return (*Duration)(d).UnmarshalText(b)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface to marshal the ISO 8601 representation of a dynamocity.ISODuration
+func (d ISODuration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// Scan implements the sql.Scanner interface with the same rules as a dynamocity.Duration
+func (d *ISODuration) Scan(src interface{}) error {
+	return (*Duration)(d).Scan(src)
+}
+
+// Value implements the driver.Valuer interface to supply a dynamocity.ISODuration to a database driver as an integer
+// number of nanoseconds
+func (d ISODuration) Value() (driver.Value, error) {
+	return int64(d), nil
+}
+
+// Add returns the dynamocity.Time t+d
+func (t Time[P]) Add(d Duration) Time[P] {
+	return Time[P](t.Time().Add(d.Duration()))
+}
+
+// Sub returns the dynamocity.Duration t-u. If the result exceeds the range of a dynamocity.Duration, the maximum
+// or minimum dynamocity.Duration is returned, as per time.Time.Sub
+func (t Time[P]) Sub(u Time[P]) Duration {
+	return Duration(t.Time().Sub(u.Time()))
+}
+
+// ParseDuration will attempt to parse the sortable encoding of a dynamocity.Duration, an ISO 8601 duration or a
+// time.Duration string to a dynamocity.Duration. An ISO 8601 duration may only use weeks, days, hours, minutes and
+// seconds, where a day is always 24 hours; years and months are not supported as their length varies
+func ParseDuration(str string) (Duration, error) {
+	if d, ok := parseSortableDuration(str); ok {
+		return d, nil
+	}
+	if d, ok := parseISODuration(str); ok {
+		return d, nil
+	}
+	if d, err := time.ParseDuration(str); err == nil {
+		return Duration(d), nil
+	}
+	return 0, fmt.Errorf("Duration '%s' cannot be unmarshalled", str)
+}
+
+// padDuration is a helper function to zero pad a non-negative number of nanoseconds to the fixed width encoding
+func padDuration(nanos int64) string {
+	return fmt.Sprintf("%0*d", durationDigits, nanos)
+}
+
+// parseSortableDuration is a helper function to parse the sortable encoding of a dynamocity.Duration
+func parseSortableDuration(str string) (Duration, bool) {
+	if len(str) != durationDigits+1 || (str[0] != 'P' && str[0] != 'N') {
+		return 0, false
+	}
+	for _, r := range str[1:] {
+		if r < '0' || r > '9' {
+			return 0, false
+		}
+	}
+	nanos, err := strconv.ParseInt(str[1:], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	if str[0] == 'N' {
+		return Duration(nanos + math.MinInt64), true
+	}
+	return Duration(nanos), true
+}
+
+// parseISODuration is a helper function to parse an ISO 8601 duration of weeks, days, hours, minutes and seconds
+func parseISODuration(str string) (Duration, bool) {
+	negative := strings.HasPrefix(str, "-")
+	str = strings.TrimPrefix(strings.TrimPrefix(str, "-"), "+")
+	if !strings.HasPrefix(str, "P") || len(str) < 3 {
+		return 0, false
+	}
+
+	datePart, timePart, hasTime := strings.Cut(str[1:], "T")
+	if hasTime && timePart == "" {
+		return 0, false
+	}
+
+	// total never exceeds math.MaxInt64, so that it can always be negated
+	var total uint64
+	add := func(value string, unit time.Duration) bool {
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || (n > 0 && n > (math.MaxInt64-total)/uint64(unit)) {
+			return false
+		}
+		total += n * uint64(unit)
+		return true
+	}
+
+	for _, part := range []struct {
+		designators string
+		units       []time.Duration
+		value       string
+	}{
+		{designators: "WD", units: []time.Duration{7 * 24 * time.Hour, 24 * time.Hour}, value: datePart},
+		{designators: "HMS", units: []time.Duration{time.Hour, time.Minute, time.Second}, value: timePart},
+	} {
+		remaining := part.value
+		next := 0
+		for remaining != "" {
+			i := strings.IndexAny(remaining, part.designators)
+			if i <= 0 {
+				return 0, false
+			}
+			designator := strings.IndexByte(part.designators, remaining[i])
+			if designator < next {
+				return 0, false
+			}
+			value := remaining[:i]
+			if part.designators[designator] == 'S' {
+				var fraction string
+				value, fraction, _ = strings.Cut(strings.Replace(value, ",", ".", 1), ".")
+				nanos, err := parseFraction(fraction)
+				if err != nil || !add(strconv.FormatInt(nanos, 10), time.Nanosecond) {
+					return 0, false
+				}
+			}
+			if !add(value, part.units[designator]) {
+				return 0, false
+			}
+			next = designator + 1
+			remaining = remaining[i+1:]
+		}
+	}
+
+	if negative {
+		return Duration(-int64(total)), true
+	}
+	return Duration(total), true
+}
@@ -0,0 +1,290 @@
+package dynamocity_test
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/edwardsmatt/dynamocity"
+)
+
+func Test_DurationMarshalling(t *testing.T) {
+	cases := []struct {
+		name        string
+		input       dynamocity.Duration
+		expected    string
+		expectedISO string
+	}{
+		{name: "Given a positive duration, then marshal P with 19 digits", input: dynamocity.Duration(90 * time.Minute), expected: "P0000005400000000000", expectedISO: "PT1H30M"},
+		{name: "Given a zero duration, then marshal P with zeros", input: 0, expected: "P0000000000000000000", expectedISO: "PT0S"},
+		{name: "Given -1ns, then marshal the largest negative encoding", input: -1, expected: "N9223372036854775807", expectedISO: "-PT0.000000001S"},
+		{name: "Given the minimum duration, then marshal the smallest negative encoding", input: math.MinInt64, expected: "N0000000000000000000", expectedISO: "-PT2562047H47M16.854775808S"},
+		{name: "Given the maximum duration, then marshal the largest positive encoding", input: math.MaxInt64, expected: "P9223372036854775807", expectedISO: "PT2562047H47M16.854775807S"},
+		{name: "Given fractional seconds, then marshal ISO seconds with a fraction", input: dynamocity.Duration(2*time.Second + 500*time.Millisecond), expected: "P0000000002500000000", expectedISO: "PT2.5S"},
+	}
+
+	for _, tc := range cases {
+		av, err := attributevalue.Marshal(tc.input)
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if actual := decodeAttributeValue(av, t); actual != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, actual)
+		}
+		if actual := tc.input.ISO(); actual != tc.expectedISO {
+			t.Errorf("%s. Expected ISO '%s', Got '%s'", tc.name, tc.expectedISO, actual)
+		}
+
+		var unmarshalled dynamocity.Duration
+		if err := attributevalue.Unmarshal(av, &unmarshalled); err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if unmarshalled != tc.input {
+			t.Errorf("%s. Expected '%d' after round trip, Got '%d'", tc.name, tc.input, unmarshalled)
+		}
+	}
+}
+
+func Test_DurationParsing(t *testing.T) {
+	cases := []struct {
+		name        string
+		input       string
+		expected    time.Duration
+		expectedErr bool
+	}{
+		{name: "Given an ISO 8601 duration, then parse", input: "PT1H30M", expected: 90 * time.Minute},
+		{name: "Given an ISO 8601 duration with days and weeks, then parse days as 24 hours", input: "P1W2DT3H", expected: 9*24*time.Hour + 3*time.Hour},
+		{name: "Given an ISO 8601 duration with a comma fraction, then parse", input: "PT0,25S", expected: 250 * time.Millisecond},
+		{name: "Given a negative ISO 8601 duration, then parse", input: "-PT15M", expected: -15 * time.Minute},
+		{name: "Given a time.Duration string, then parse", input: "1h30m", expected: 90 * time.Minute},
+		{name: "Given an ISO 8601 duration with months, then error", input: "P1M", expectedErr: true},
+		{name: "Given an ISO 8601 duration with designators out of order, then error", input: "PT1S1M", expectedErr: true},
+		{name: "Given an ISO 8601 duration without values, then error", input: "PT", expectedErr: true},
+		{name: "Given an ISO 8601 duration which overflows, then error", input: "PT9999999999H", expectedErr: true},
+		{name: "Given a sortable encoding with a sign, then error", input: "P+000000000000000001", expectedErr: true},
+	}
+
+	for _, tc := range cases {
+		actual, err := dynamocity.ParseDuration(tc.input)
+		if tc.expectedErr {
+			if err == nil {
+				t.Errorf("%s. Expected an error, Got '%v'", tc.name, actual.Duration())
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if actual.Duration() != tc.expected {
+			t.Errorf("%s. Expected '%v', Got '%v'", tc.name, tc.expected, actual.Duration())
+		}
+	}
+}
+
+func Test_DurationUnmarshalNumber(t *testing.T) {
+	type Legacy struct {
+		Timeout time.Duration `dynamodbav:"timeout"`
+	}
+	type TestType struct {
+		Timeout dynamocity.Duration `dynamodbav:"timeout"`
+	}
+
+	item, err := attributevalue.MarshalMap(Legacy{Timeout: 30 * time.Second})
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if _, ok := item["timeout"].(*types.AttributeValueMemberN); !ok {
+		t.Errorf("Expected a time.Duration to marshal as a number. Got %T", item["timeout"])
+	}
+
+	var unmarshalled TestType
+	if err := attributevalue.UnmarshalMap(item, &unmarshalled); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if unmarshalled.Timeout.Duration() != 30*time.Second {
+		t.Errorf("Unexpected unmarshalled dynamocity.Duration. Expected '%v', Got '%v'", 30*time.Second, unmarshalled.Timeout.Duration())
+	}
+}
+
+func Test_DurationJSON(t *testing.T) {
+	type TestType struct {
+		Sortable dynamocity.Duration    `json:"sortable"`
+		Human    dynamocity.ISODuration `json:"human"`
+	}
+
+	testCase := TestType{
+		Sortable: dynamocity.Duration(90 * time.Minute),
+		Human:    dynamocity.ISODuration(90 * time.Minute),
+	}
+
+	jsonBytes, err := json.Marshal(testCase)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	expected := `{"sortable":"P0000005400000000000","human":"PT1H30M"}`
+	if string(jsonBytes) != expected {
+		t.Errorf("Unexpected marshalled JSON. Expected '%s', Got '%s'", expected, string(jsonBytes))
+	}
+
+	var fromJSON TestType
+	if err := json.Unmarshal(jsonBytes, &fromJSON); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if fromJSON != testCase {
+		t.Errorf("Unexpected unmarshalled JSON. Expected '%v', Got '%v'", testCase, fromJSON)
+	}
+
+	av, err := attributevalue.Marshal(testCase.Human)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if actual := decodeAttributeValue(av, t); actual != "P0000005400000000000" {
+		t.Errorf("Expected a dynamocity.ISODuration to marshal the sortable encoding to DynamoDB. Got '%s'", actual)
+	}
+}
+
+func Test_DurationSortable(t *testing.T) {
+	durations := []dynamocity.Duration{
+		dynamocity.Duration(time.Hour),
+		dynamocity.Duration(-time.Hour),
+		0,
+		dynamocity.Duration(-time.Nanosecond),
+		dynamocity.Duration(time.Nanosecond),
+		math.MinInt64,
+		math.MaxInt64,
+	}
+
+	keys := make([]string, len(durations))
+	for i, d := range durations {
+		keys[i] = d.String()
+	}
+	sort.Strings(keys)
+
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	for i, d := range durations {
+		if keys[i] != d.String() {
+			t.Errorf("Expected string order to match numeric order. Expected '%s', Got '%s'", d, keys[i])
+		}
+	}
+}
+
+func Test_DurationArithmetic(t *testing.T) {
+	start := dynamocity.MillisTime(time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC))
+
+	end := start.Add(dynamocity.Duration(90 * time.Minute))
+	if expected := "2020-04-01T15:30:00.000Z"; end.String() != expected {
+		t.Errorf("Unexpected dynamocity.Time after Add. Expected '%s', Got '%s'", expected, end)
+	}
+	if elapsed := end.Sub(start); elapsed.Duration() != 90*time.Minute {
+		t.Errorf("Unexpected dynamocity.Duration from Sub. Expected '%v', Got '%v'", 90*time.Minute, elapsed.Duration())
+	}
+	if elapsed := start.Sub(end); elapsed.Duration() != -90*time.Minute {
+		t.Errorf("Unexpected negative dynamocity.Duration from Sub. Expected '%v', Got '%v'", -90*time.Minute, elapsed.Duration())
+	}
+}
+
+func Test_DurationText(t *testing.T) {
+	cases := []struct {
+		name        string
+		input       string
+		expected    dynamocity.Duration
+		expectedErr bool
+	}{
+		{name: "Given the sortable encoding, then unmarshal", input: "P0000005400000000000", expected: dynamocity.Duration(90 * time.Minute)},
+		{name: "Given a negative sortable encoding, then unmarshal", input: "N9223372036854775807", expected: -1},
+		{name: "Given an ISO 8601 duration, then unmarshal", input: "PT1H30M", expected: dynamocity.Duration(90 * time.Minute)},
+		{name: "Given a time.Duration string, then unmarshal", input: "1h30m", expected: dynamocity.Duration(90 * time.Minute)},
+		{name: "Given an invalid duration, then error", input: "forever", expectedErr: true},
+	}
+
+	for _, tc := range cases {
+		var unmarshalled dynamocity.Duration
+		err := unmarshalled.UnmarshalText([]byte(tc.input))
+		if tc.expectedErr {
+			if err == nil {
+				t.Errorf("%s. Expected an error, Got '%d'", tc.name, unmarshalled)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if unmarshalled != tc.expected {
+			t.Errorf("%s. Expected '%d', Got '%d'", tc.name, tc.expected, unmarshalled)
+		}
+
+		text, err := unmarshalled.MarshalText()
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if string(text) != tc.expected.String() {
+			t.Errorf("%s. Expected the sortable encoding '%s', Got '%s'", tc.name, tc.expected, text)
+		}
+
+		var roundTripped dynamocity.Duration
+		if err := roundTripped.UnmarshalText(text); err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if roundTripped != tc.expected {
+			t.Errorf("%s. Expected '%d' after text round trip, Got '%d'", tc.name, tc.expected, roundTripped)
+		}
+	}
+}
+
+func Test_DurationSQL(t *testing.T) {
+	cases := []struct {
+		name        string
+		src         interface{}
+		expected    dynamocity.Duration
+		expectedErr bool
+	}{
+		{name: "Given a SQL NULL, then scan a zero duration", src: nil, expected: 0},
+		{name: "Given an integer number of nanoseconds, then scan", src: int64(90 * time.Minute), expected: dynamocity.Duration(90 * time.Minute)},
+		{name: "Given a sortable string, then scan", src: "P0000005400000000000", expected: dynamocity.Duration(90 * time.Minute)},
+		{name: "Given ISO 8601 bytes, then scan", src: []byte("PT1H30M"), expected: dynamocity.Duration(90 * time.Minute)},
+		{name: "Given an invalid string, then error", src: "forever", expectedErr: true},
+		{name: "Given an unsupported type, then error", src: 1.5, expectedErr: true},
+	}
+
+	for _, tc := range cases {
+		scanned := dynamocity.Duration(time.Second)
+		err := scanned.Scan(tc.src)
+		if tc.expectedErr {
+			if err == nil {
+				t.Errorf("%s. Expected an error, Got '%d'", tc.name, scanned)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if scanned != tc.expected {
+			t.Errorf("%s. Expected '%d', Got '%d'", tc.name, tc.expected, scanned)
+		}
+
+		value, err := scanned.Value()
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if value != int64(tc.expected) {
+			t.Errorf("%s. Expected the driver value '%d', Got '%v'", tc.name, int64(tc.expected), value)
+		}
+	}
+}
@@ -0,0 +1,337 @@
+package dynamocity
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// EpochSeconds represents a Timestamp as the number of seconds elapsed since the Unix epoch.
+// EpochSeconds implements attributevalue.Marshaler, attributevalue.Unmarshaler using a DynamoDB Number
+// attribute value, which is the format required for a DynamoDB TTL attribute.
+type EpochSeconds time.Time
+
+// EpochMillis represents a Timestamp as the number of milliseconds elapsed since the Unix epoch.
+// EpochMillis implements attributevalue.Marshaler, attributevalue.Unmarshaler using a DynamoDB Number
+// attribute value.
+type EpochMillis time.Time
+
+// EpochNanos represents a Timestamp as the number of nanoseconds elapsed since the Unix epoch.
+// EpochNanos implements attributevalue.Marshaler, attributevalue.Unmarshaler using a DynamoDB Number
+// attribute value. The result of marshalling is undefined if the Timestamp cannot be represented by an int64
+// (a date before the year 1678 or after 2262).
+type EpochNanos time.Time
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal
+// a dynamocity.EpochSeconds into a DynamoDB AttributeValue number value.
+func (t EpochSeconds) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return t.attributeValue(), nil
+}
+
+// attributeValue is a helper function to marshal a dynamocity.EpochSeconds into a number AttributeValue
+func (t EpochSeconds) attributeValue() types.AttributeValue {
+	return &types.AttributeValueMemberN{
+		Value: t.String(),
+	}
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal
+// a types.AttributeValue number value into a dynamocity.EpochSeconds. A NULL AttributeValue is unmarshalled as a zero dynamocity.EpochSeconds
+func (t *EpochSeconds) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if isNull(av) {
+		*t = EpochSeconds{}
+		return nil
+	}
+	epoch, err := unmarshalEpoch(av, reflect.TypeOf((*EpochSeconds)(nil)))
+	if err != nil {
+		return err
+	}
+	*t = EpochSeconds(time.Unix(epoch, 0).UTC())
+	return nil
+}
+
+// IsZero reports whether this dynamocity.EpochSeconds represents the zero time instant, rather than the Unix epoch
+func (t EpochSeconds) IsZero() bool {
+	return t.Time().IsZero()
+}
+
+// Time is a handler func to return an instance of dynamocity.EpochSeconds as time.Time
+func (t EpochSeconds) Time() time.Time {
+	return time.Time(t)
+}
+
+// String implements the fmt.Stringer interface to supply the number of seconds since the Unix epoch
+func (t EpochSeconds) String() string {
+	return strconv.FormatInt(t.Time().Unix(), 10)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal a JSON number of seconds since the Unix epoch. A JSON null is a no-op
+func (t *EpochSeconds) UnmarshalJSON(b []byte) error {
+	if isJSONNull(b) {
+		return nil
+	}
+	epoch, err := parseEpoch(string(b))
+	if err != nil {
+		return err
+	}
+	*t = EpochSeconds(time.Unix(epoch, 0).UTC())
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal a JSON number of seconds since the Unix epoch
+func (t EpochSeconds) MarshalJSON() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface to unmarshal a number of seconds since the Unix epoch
+func (t *EpochSeconds) UnmarshalText(b []byte) error {
+	epoch, err := parseEpoch(string(b))
+	if err != nil {
+		return err
+	}
+	*t = EpochSeconds(time.Unix(epoch, 0).UTC())
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface to marshal a number of seconds since the Unix epoch
+func (t EpochSeconds) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// NanoTime returns this dynamocity.EpochSeconds as a dynamocity.NanoTime
+func (t EpochSeconds) NanoTime() NanoTime {
+	return NanoTime(t)
+}
+
+// MicrosTime returns this dynamocity.EpochSeconds as a dynamocity.MicrosTime
+func (t EpochSeconds) MicrosTime() MicrosTime {
+	return MicrosTime(t)
+}
+
+// MillisTime returns this dynamocity.EpochSeconds as a dynamocity.MillisTime
+func (t EpochSeconds) MillisTime() MillisTime {
+	return MillisTime(t)
+}
+
+// SecondsTime returns this dynamocity.EpochSeconds as a dynamocity.SecondsTime
+func (t EpochSeconds) SecondsTime() SecondsTime {
+	return SecondsTime(t)
+}
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal
+// a dynamocity.EpochMillis into a DynamoDB AttributeValue number value.
+func (t EpochMillis) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return t.attributeValue(), nil
+}
+
+// attributeValue is a helper function to marshal a dynamocity.EpochMillis into a number AttributeValue
+func (t EpochMillis) attributeValue() types.AttributeValue {
+	return &types.AttributeValueMemberN{
+		Value: t.String(),
+	}
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal
+// a types.AttributeValue number value into a dynamocity.EpochMillis. A NULL AttributeValue is unmarshalled as a zero dynamocity.EpochMillis
+func (t *EpochMillis) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if isNull(av) {
+		*t = EpochMillis{}
+		return nil
+	}
+	epoch, err := unmarshalEpoch(av, reflect.TypeOf((*EpochMillis)(nil)))
+	if err != nil {
+		return err
+	}
+	*t = EpochMillis(time.UnixMilli(epoch).UTC())
+	return nil
+}
+
+// IsZero reports whether this dynamocity.EpochMillis represents the zero time instant, rather than the Unix epoch
+func (t EpochMillis) IsZero() bool {
+	return t.Time().IsZero()
+}
+
+// Time is a handler func to return an instance of dynamocity.EpochMillis as time.Time
+func (t EpochMillis) Time() time.Time {
+	return time.Time(t)
+}
+
+// String implements the fmt.Stringer interface to supply the number of milliseconds since the Unix epoch
+func (t EpochMillis) String() string {
+	return strconv.FormatInt(t.Time().UnixMilli(), 10)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal a JSON number of milliseconds since the Unix epoch. A JSON null is a no-op
+func (t *EpochMillis) UnmarshalJSON(b []byte) error {
+	if isJSONNull(b) {
+		return nil
+	}
+	epoch, err := parseEpoch(string(b))
+	if err != nil {
+		return err
+	}
+	*t = EpochMillis(time.UnixMilli(epoch).UTC())
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal a JSON number of milliseconds since the Unix epoch
+func (t EpochMillis) MarshalJSON() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface to unmarshal a number of milliseconds since the Unix epoch
+func (t *EpochMillis) UnmarshalText(b []byte) error {
+	epoch, err := parseEpoch(string(b))
+	if err != nil {
+		return err
+	}
+	*t = EpochMillis(time.UnixMilli(epoch).UTC())
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface to marshal a number of milliseconds since the Unix epoch
+func (t EpochMillis) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// NanoTime returns this dynamocity.EpochMillis as a dynamocity.NanoTime
+func (t EpochMillis) NanoTime() NanoTime {
+	return NanoTime(t)
+}
+
+// MicrosTime returns this dynamocity.EpochMillis as a dynamocity.MicrosTime
+func (t EpochMillis) MicrosTime() MicrosTime {
+	return MicrosTime(t)
+}
+
+// MillisTime returns this dynamocity.EpochMillis as a dynamocity.MillisTime
+func (t EpochMillis) MillisTime() MillisTime {
+	return MillisTime(t)
+}
+
+// SecondsTime returns this dynamocity.EpochMillis as a dynamocity.SecondsTime
+func (t EpochMillis) SecondsTime() SecondsTime {
+	return SecondsTime(t)
+}
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal
+// a dynamocity.EpochNanos into a DynamoDB AttributeValue number value.
+func (t EpochNanos) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return t.attributeValue(), nil
+}
+
+// attributeValue is a helper function to marshal a dynamocity.EpochNanos into a number AttributeValue
+func (t EpochNanos) attributeValue() types.AttributeValue {
+	return &types.AttributeValueMemberN{
+		Value: t.String(),
+	}
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal
+// a types.AttributeValue number value into a dynamocity.EpochNanos. A NULL AttributeValue is unmarshalled as a zero dynamocity.EpochNanos
+func (t *EpochNanos) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if isNull(av) {
+		*t = EpochNanos{}
+		return nil
+	}
+	epoch, err := unmarshalEpoch(av, reflect.TypeOf((*EpochNanos)(nil)))
+	if err != nil {
+		return err
+	}
+	*t = EpochNanos(time.Unix(0, epoch).UTC())
+	return nil
+}
+
+// IsZero reports whether this dynamocity.EpochNanos represents the zero time instant, rather than the Unix epoch
+func (t EpochNanos) IsZero() bool {
+	return t.Time().IsZero()
+}
+
+// Time is a handler func to return an instance of dynamocity.EpochNanos as time.Time
+func (t EpochNanos) Time() time.Time {
+	return time.Time(t)
+}
+
+// String implements the fmt.Stringer interface to supply the number of nanoseconds since the Unix epoch
+func (t EpochNanos) String() string {
+	return strconv.FormatInt(t.Time().UnixNano(), 10)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal a JSON number of nanoseconds since the Unix epoch. A JSON null is a no-op
+func (t *EpochNanos) UnmarshalJSON(b []byte) error {
+	if isJSONNull(b) {
+		return nil
+	}
+	epoch, err := parseEpoch(string(b))
+	if err != nil {
+		return err
+	}
+	*t = EpochNanos(time.Unix(0, epoch).UTC())
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal a JSON number of nanoseconds since the Unix epoch
+func (t EpochNanos) MarshalJSON() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface to unmarshal a number of nanoseconds since the Unix epoch
+func (t *EpochNanos) UnmarshalText(b []byte) error {
+	epoch, err := parseEpoch(string(b))
+	if err != nil {
+		return err
+	}
+	*t = EpochNanos(time.Unix(0, epoch).UTC())
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface to marshal a number of nanoseconds since the Unix epoch
+func (t EpochNanos) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// NanoTime returns this dynamocity.EpochNanos as a dynamocity.NanoTime
+func (t EpochNanos) NanoTime() NanoTime {
+	return NanoTime(t)
+}
+
+// MicrosTime returns this dynamocity.EpochNanos as a dynamocity.MicrosTime
+func (t EpochNanos) MicrosTime() MicrosTime {
+	return MicrosTime(t)
+}
+
+// MillisTime returns this dynamocity.EpochNanos as a dynamocity.MillisTime
+func (t EpochNanos) MillisTime() MillisTime {
+	return MillisTime(t)
+}
+
+// SecondsTime returns this dynamocity.EpochNanos as a dynamocity.SecondsTime
+func (t EpochNanos) SecondsTime() SecondsTime {
+	return SecondsTime(t)
+}
+
+// unmarshalEpoch is a helper function to extract an integer from a types.AttributeValueMemberN, returning an
+// attributevalue.UnmarshalTypeError for the specified type if the types.AttributeValue is not a number
+func unmarshalEpoch(av types.AttributeValue, typ reflect.Type) (int64, error) {
+	tv, ok := av.(*types.AttributeValueMemberN)
+	if !ok {
+		return 0, &attributevalue.UnmarshalTypeError{
+			Value: fmt.Sprintf("%T", av),
+			Type:  typ,
+		}
+	}
+	return parseEpoch(tv.Value)
+}
+
+// parseEpoch is a helper function to parse a string as an integer offset from the Unix epoch
+func parseEpoch(str string) (int64, error) {
+	epoch, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("Epoch '%s' cannot be unmarshalled as a valid integer", str)
+	}
+	return epoch, nil
+}
@@ -0,0 +1,160 @@
+package dynamocity_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/edwardsmatt/dynamocity"
+)
+
+func Test_EpochAttributeValueRoundTrip(t *testing.T) {
+	type TestType struct {
+		ExpiresAt  dynamocity.EpochSeconds `dynamodbav:"expiresAt"`
+		CreatedAt  dynamocity.EpochMillis  `dynamodbav:"createdAt"`
+		ObservedAt dynamocity.EpochNanos   `dynamodbav:"observedAt"`
+	}
+
+	timestamp := time.Date(2020, time.April, 1, 14, 0, 0, 123456789, time.UTC)
+	testCase := TestType{
+		ExpiresAt:  dynamocity.EpochSeconds(timestamp),
+		CreatedAt:  dynamocity.EpochMillis(timestamp),
+		ObservedAt: dynamocity.EpochNanos(timestamp),
+	}
+
+	item, err := attributevalue.MarshalMap(testCase)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	expectedNumbers := map[string]string{
+		"expiresAt":  "1585749600",
+		"createdAt":  "1585749600123",
+		"observedAt": "1585749600123456789",
+	}
+
+	for name, expected := range expectedNumbers {
+		n, ok := item[name].(*types.AttributeValueMemberN)
+		if !ok {
+			t.Errorf("Unexpected Attribute Value Member Type for '%s'. Got %T", name, item[name])
+			continue
+		}
+		if n.Value != expected {
+			t.Errorf("Unexpected number attribute value for '%s'. Expected '%s', Got '%s'", name, expected, n.Value)
+		}
+	}
+
+	var unmarshalled TestType
+	if err := attributevalue.UnmarshalMap(item, &unmarshalled); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if !unmarshalled.ExpiresAt.Time().Equal(timestamp.Truncate(time.Second)) {
+		t.Errorf("Unexpected unmarshalled EpochSeconds. Got '%v', want '%v'", unmarshalled.ExpiresAt.Time(), timestamp.Truncate(time.Second))
+	}
+	if !unmarshalled.CreatedAt.Time().Equal(timestamp.Truncate(time.Millisecond)) {
+		t.Errorf("Unexpected unmarshalled EpochMillis. Got '%v', want '%v'", unmarshalled.CreatedAt.Time(), timestamp.Truncate(time.Millisecond))
+	}
+	if !unmarshalled.ObservedAt.Time().Equal(timestamp) {
+		t.Errorf("Unexpected unmarshalled EpochNanos. Got '%v', want '%v'", unmarshalled.ObservedAt.Time(), timestamp)
+	}
+}
+
+func Test_EpochUnmarshalStringAttributeValue(t *testing.T) {
+	var epoch dynamocity.EpochSeconds
+	err := attributevalue.Unmarshal(&types.AttributeValueMemberS{Value: "1585749600"}, &epoch)
+	if err == nil {
+		t.Errorf("Expected an error when unmarshalling a string attribute value into a dynamocity.EpochSeconds")
+	}
+}
+
+func Test_EpochJSONRoundTrip(t *testing.T) {
+	type TestType struct {
+		EpochSeconds dynamocity.EpochSeconds `json:"epochSeconds"`
+		EpochMillis  dynamocity.EpochMillis  `json:"epochMillis"`
+		EpochNanos   dynamocity.EpochNanos   `json:"epochNanos"`
+	}
+
+	timestamp := time.Date(2020, time.April, 1, 14, 0, 0, 123456789, time.UTC)
+	testCase := TestType{
+		EpochSeconds: dynamocity.EpochSeconds(timestamp),
+		EpochMillis:  dynamocity.EpochMillis(timestamp),
+		EpochNanos:   dynamocity.EpochNanos(timestamp),
+	}
+	expected := `{"epochSeconds":1585749600,"epochMillis":1585749600123,"epochNanos":1585749600123456789}`
+
+	actualBytes, err := json.Marshal(testCase)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if string(actualBytes) != expected {
+		t.Errorf("Unexpected marshalled JSON. Got '%s', want '%s'", string(actualBytes), expected)
+	}
+
+	var unmarshalled TestType
+	if err := json.Unmarshal(actualBytes, &unmarshalled); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if unmarshalled.EpochSeconds.String() != "1585749600" {
+		t.Errorf("Unexpected unmarshalled EpochSeconds. Got '%s'", unmarshalled.EpochSeconds)
+	}
+	if unmarshalled.EpochMillis.String() != "1585749600123" {
+		t.Errorf("Unexpected unmarshalled EpochMillis. Got '%s'", unmarshalled.EpochMillis)
+	}
+	if unmarshalled.EpochNanos.String() != "1585749600123456789" {
+		t.Errorf("Unexpected unmarshalled EpochNanos. Got '%s'", unmarshalled.EpochNanos)
+	}
+}
+
+func Test_EpochConversions(t *testing.T) {
+	timestamp := time.Date(2020, time.April, 1, 14, 0, 0, 123456789, time.UTC)
+
+	cases := []struct {
+		name     string
+		actual   string
+		expected string
+	}{
+		{
+			name:     "Given a dynamocity.EpochSeconds, when converted to a dynamocity.MillisTime, then marshal with millisecond precision",
+			actual:   dynamocity.EpochSeconds(timestamp).MillisTime().String(),
+			expected: "2020-04-01T14:00:00.123Z",
+		},
+		{
+			name:     "Given a dynamocity.EpochMillis, when converted to a dynamocity.NanoTime, then marshal with nanosecond precision",
+			actual:   dynamocity.EpochMillis(timestamp).NanoTime().String(),
+			expected: "2020-04-01T14:00:00.123456789Z",
+		},
+		{
+			name:     "Given a dynamocity.EpochNanos, when converted to a dynamocity.SecondsTime, then marshal with second precision",
+			actual:   dynamocity.EpochNanos(timestamp).SecondsTime().String(),
+			expected: "2020-04-01T14:00:00Z",
+		},
+		{
+			name:     "Given a dynamocity.MillisTime, when converted to a dynamocity.EpochSeconds, then marshal as seconds",
+			actual:   dynamocity.MillisTime(timestamp).EpochSeconds().String(),
+			expected: "1585749600",
+		},
+		{
+			name:     "Given a dynamocity.SecondsTime, when converted to a dynamocity.EpochMillis, then marshal as milliseconds",
+			actual:   dynamocity.SecondsTime(timestamp).EpochMillis().String(),
+			expected: "1585749600123",
+		},
+		{
+			name:     "Given a dynamocity.NanoTime, when converted to a dynamocity.EpochNanos, then marshal as nanoseconds",
+			actual:   dynamocity.NanoTime(timestamp).EpochNanos().String(),
+			expected: "1585749600123456789",
+		},
+	}
+
+	for _, tc := range cases {
+		if tc.actual != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, tc.actual)
+		}
+	}
+}
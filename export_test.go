@@ -0,0 +1,7 @@
+package dynamocity
+
+// IsLocationCached reports whether a time.Location name is held in the location cache
+func IsLocationCached(name string) bool {
+	_, ok := locations.Load(name)
+	return ok
+}
@@ -0,0 +1,128 @@
+package dynamocity
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+)
+
+// Granularity represents a calendar unit of a Timestamp, which selects the length of a canonical prefix of its
+// fixed precision encoding
+type Granularity int
+
+const (
+	// YearGranularity selects the year of a Timestamp, for example `2020`
+	YearGranularity Granularity = iota + 1
+	// MonthGranularity selects the year and month of a Timestamp, for example `2020-04`
+	MonthGranularity
+	// DayGranularity selects the date of a Timestamp, for example `2020-04-01`
+	DayGranularity
+	// HourGranularity selects the date and hour of a Timestamp, for example `2020-04-01T14`
+	HourGranularity
+	// MinuteGranularity selects the date, hour and minute of a Timestamp, for example `2020-04-01T14:00`
+	MinuteGranularity
+	// SecondGranularity selects the date and time of a Timestamp to the second, for example `2020-04-01T14:00:05`
+	SecondGranularity
+)
+
+// granularityLengths is the length of the prefix of each Granularity, which is the same for every layout
+var granularityLengths = map[Granularity]int{
+	YearGranularity:   len("2006"),
+	MonthGranularity:  len("2006-01"),
+	DayGranularity:    len("2006-01-02"),
+	HourGranularity:   len("2006-01-02T15"),
+	MinuteGranularity: len("2006-01-02T15:04"),
+	SecondGranularity: len("2006-01-02T15:04:05"),
+}
+
+// String implements the fmt.Stringer interface to supply the name of the Granularity
+func (g Granularity) String() string {
+	switch g {
+	case YearGranularity:
+		return "Year"
+	case MonthGranularity:
+		return "Month"
+	case DayGranularity:
+		return "Day"
+	case HourGranularity:
+		return "Hour"
+	case MinuteGranularity:
+		return "Minute"
+	case SecondGranularity:
+		return "Second"
+	default:
+		return "Unknown"
+	}
+}
+
+// truncate is a helper function to round a time.Time down to the start of its UTC calendar unit of this Granularity
+func (g Granularity) truncate(t time.Time) time.Time {
+	t = t.UTC()
+	switch g {
+	case YearGranularity:
+		return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	case MonthGranularity:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case DayGranularity:
+		return midnight(t)
+	case HourGranularity:
+		return t.Truncate(time.Hour)
+	case MinuteGranularity:
+		return t.Truncate(time.Minute)
+	default:
+		return t.Truncate(time.Second)
+	}
+}
+
+// next is a helper function to return the start of the UTC calendar unit of this Granularity following the start t
+func (g Granularity) next(t time.Time) time.Time {
+	switch g {
+	case YearGranularity:
+		return t.AddDate(1, 0, 0)
+	case MonthGranularity:
+		return t.AddDate(0, 1, 0)
+	case DayGranularity:
+		return t.AddDate(0, 0, 1)
+	case HourGranularity:
+		return t.Add(time.Hour)
+	case MinuteGranularity:
+		return t.Add(time.Minute)
+	default:
+		return t.Add(time.Second)
+	}
+}
+
+// prefix is a helper function to return the prefix of a marshalled value at the supplied Granularity. An unknown
+// Granularity, or a Granularity finer than the value, returns the whole value
+func prefix(str string, g Granularity) string {
+	if length, ok := granularityLengths[g]; ok && length < len(str) {
+		return str[:length]
+	}
+	return str
+}
+
+// Prefix returns the canonical prefix of the marshalled dynamocity.Time at the supplied Granularity, which is always in
+// UTC even with a dynamocity.Offset precision, as per the bounds of a KeyCondition. For example, the MillisTime
+// `2020-04-01T14:00:05.123Z` has the HourGranularity prefix `2020-04-01T14`. A Granularity finer than P returns the
+// whole marshalled value
+func (t Time[P]) Prefix(g Granularity) string {
+	return prefix(t.utcString(), g)
+}
+
+// BeginsWith returns an expression.KeyConditionBuilder which selects the values of the sort key name in the same
+// Granularity as this dynamocity.Time, using begins_with with its Prefix
+func (t Time[P]) BeginsWith(name string, g Granularity) expression.KeyConditionBuilder {
+	return expression.Key(name).BeginsWith(t.Prefix(g))
+}
+
+// Prefix returns the canonical prefix of the marshalled dynamocity.Date at the supplied Granularity. As a Date has
+// no time of day, a Granularity finer than DayGranularity returns the whole marshalled date
+func (t Date) Prefix(g Granularity) string {
+	return prefix(t.String(), g)
+}
+
+// BeginsWith returns an expression.KeyConditionBuilder which selects the values of the sort key name in the same
+// Granularity as this dynamocity.Date, using begins_with with its Prefix
+func (t Date) BeginsWith(name string, g Granularity) expression.KeyConditionBuilder {
+	return expression.Key(name).BeginsWith(t.Prefix(g))
+}
@@ -0,0 +1,73 @@
+package dynamocity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/edwardsmatt/dynamocity"
+)
+
+func Test_GranularityPrefix(t *testing.T) {
+	instant := time.Date(2020, time.April, 2, 0, 0, 5, 123456789, time.FixedZone("AEST", 10*60*60))
+
+	cases := []struct {
+		name     string
+		actual   string
+		expected string
+	}{
+		{name: "Given YearGranularity, then the UTC year", actual: dynamocity.MillisTime(instant).Prefix(dynamocity.YearGranularity), expected: "2020"},
+		{name: "Given MonthGranularity, then the UTC month", actual: dynamocity.MillisTime(instant).Prefix(dynamocity.MonthGranularity), expected: "2020-04"},
+		{name: "Given DayGranularity, then the UTC date", actual: dynamocity.MillisTime(instant).Prefix(dynamocity.DayGranularity), expected: "2020-04-01"},
+		{name: "Given HourGranularity, then the UTC hour", actual: dynamocity.NanoTime(instant).Prefix(dynamocity.HourGranularity), expected: "2020-04-01T14"},
+		{name: "Given MinuteGranularity, then the UTC minute", actual: dynamocity.SecondsTime(instant).Prefix(dynamocity.MinuteGranularity), expected: "2020-04-01T14:00"},
+		{name: "Given SecondGranularity, then exclude the fraction", actual: dynamocity.MicrosTime(instant).Prefix(dynamocity.SecondGranularity), expected: "2020-04-01T14:00:05"},
+		{name: "Given an unknown Granularity, then the whole value", actual: dynamocity.MillisTime(instant).Prefix(dynamocity.Granularity(0)), expected: "2020-04-01T14:00:05.123Z"},
+		{name: "Given a Date with MonthGranularity, then the month", actual: dynamocity.Date(instant).Prefix(dynamocity.MonthGranularity), expected: "2020-04"},
+		{name: "Given a Date with HourGranularity, then the whole date", actual: dynamocity.Date(instant).Prefix(dynamocity.HourGranularity), expected: "2020-04-02"},
+	}
+
+	for _, tc := range cases {
+		if tc.actual != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, tc.actual)
+		}
+	}
+
+	if actual := dynamocity.OffsetMillisTime(instant).Prefix(dynamocity.DayGranularity); actual != "2020-04-01" {
+		t.Errorf("Expected the UTC date regardless of an Offset precision. Got '%s'", actual)
+	}
+}
+
+func Test_GranularityPrefixMatchesEncoding(t *testing.T) {
+	granularities := []dynamocity.Granularity{
+		dynamocity.YearGranularity,
+		dynamocity.MonthGranularity,
+		dynamocity.DayGranularity,
+		dynamocity.HourGranularity,
+		dynamocity.MinuteGranularity,
+		dynamocity.SecondGranularity,
+	}
+	start := time.Date(2020, time.April, 1, 14, 0, 5, 0, time.UTC)
+
+	for _, g := range granularities {
+		reference := dynamocity.MillisTime(start)
+		for _, offset := range []time.Duration{-time.Second, -time.Millisecond, 0, time.Millisecond, time.Second, time.Minute, time.Hour, 24 * time.Hour, 31 * 24 * time.Hour, 366 * 24 * time.Hour} {
+			candidate := dynamocity.MillisTime(start.Add(offset))
+			matches := strings.HasPrefix(candidate.String(), reference.Prefix(g))
+			if matches != (reference.Prefix(g) == candidate.Prefix(g)) {
+				t.Errorf("Given %s, then expected the prefix '%s' to match '%s' only if they share the prefix. Got '%t'", g, reference.Prefix(g), candidate, matches)
+			}
+		}
+	}
+
+	keyCondition := dynamocity.Date(start).BeginsWith("sk", dynamocity.DayGranularity)
+	expr, err := expression.NewBuilder().WithKeyCondition(keyCondition).Build()
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if *expr.KeyCondition() != "begins_with (#0, :0)" || decodeAttributeValue(expr.Values()[":0"], t) != "2020-04-01" {
+		t.Errorf("Unexpected KeyCondition '%s' with values '%v'", *expr.KeyCondition(), expr.Values())
+	}
+}
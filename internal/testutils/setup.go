@@ -19,6 +19,7 @@ type TestDynamoItem struct {
 	SortKey      string                 `dynamodbav:"sk"`
 	GoTime       time.Time              `dynamodbav:"goTime"`
 	NanoTime     dynamocity.NanoTime    `dynamodbav:"nanoTime"`
+	MicrosTime   dynamocity.MicrosTime  `dynamodbav:"microsTime"`
 	MillisTime   dynamocity.MillisTime  `dynamodbav:"millisTime"`
 	SecondsTime  dynamocity.SecondsTime `dynamodbav:"secondsTime"`
 	StringTime   string                 `dynamodbav:"timestamp"`
@@ -51,6 +52,15 @@ var NanoTimeKeyBuilder = func(tc SortKeyTestCase, t *testing.T) interface{} {
 	return dynamocity.NanoTime(timestamp)
 }
 
+var MicrosTimeKeyBuilder = func(tc SortKeyTestCase, t *testing.T) interface{} {
+	timestamp, err := time.Parse(time.RFC3339Nano, tc.Timestamp)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	return dynamocity.MicrosTime(timestamp)
+}
+
 var MillisTimeKeyBuilder = func(tc SortKeyTestCase, t *testing.T) interface{} {
 	timestamp, err := time.Parse(time.RFC3339Nano, tc.Timestamp)
 	if err != nil {
@@ -126,6 +136,7 @@ func MakeTestTable(db *dynamodb.Client) (*string, error) {
 	sk := MakeAttribute("sk", types.ScalarAttributeTypeS)
 	nanoTime := MakeAttribute("nanoTime", types.ScalarAttributeTypeS)
 	goTime := MakeAttribute("goTime", types.ScalarAttributeTypeS)
+	microsTime := MakeAttribute("microsTime", types.ScalarAttributeTypeS)
 	millisTime := MakeAttribute("millisTime", types.ScalarAttributeTypeS)
 	secondsTime := MakeAttribute("secondsTime", types.ScalarAttributeTypeS)
 
@@ -134,6 +145,7 @@ func MakeTestTable(db *dynamodb.Client) (*string, error) {
 		sk.AttributeDefinition(),
 		nanoTime.AttributeDefinition(),
 		goTime.AttributeDefinition(),
+		microsTime.AttributeDefinition(),
 		millisTime.AttributeDefinition(),
 		secondsTime.AttributeDefinition(),
 	}
@@ -151,6 +163,7 @@ func MakeTestTable(db *dynamodb.Client) (*string, error) {
 
 	gsis := GlobalSecondaryIndexes{
 		GSI("nano-time-index", *pk, *nanoTime, types.ProjectionTypeAll, defaultThroughput, nil),
+		GSI("micros-time-index", *pk, *microsTime, types.ProjectionTypeAll, defaultThroughput, nil),
 		GSI("millis-time-index", *pk, *millisTime, types.ProjectionTypeAll, defaultThroughput, nil),
 		GSI("seconds-time-index", *pk, *secondsTime, types.ProjectionTypeAll, defaultThroughput, nil),
 	}
@@ -232,6 +245,7 @@ func SetupTestFixtures() (*dynamodb.Client, *string, []TestDynamoItem, error) {
 		}
 		item.GoTime = goTime
 		item.NanoTime = dynamocity.NanoTime(goTime)
+		item.MicrosTime = dynamocity.MicrosTime(goTime)
 		item.MillisTime = dynamocity.MillisTime(goTime)
 		item.SecondsTime = dynamocity.SecondsTime(goTime)
 
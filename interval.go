@@ -0,0 +1,311 @@
+package dynamocity
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// Interval represents the time between a Start and End dynamocity.Time with the fixed precision supplied by P, where
+// each end may be inclusive or exclusive.
+//
+// Interval is marshalled as an ISO 8601 interval of the form `start/end`, formatting each end with the layout of P. A
+// plain ISO 8601 interval is half-open, with an inclusive Start and an exclusive End, which is the Interval returned by
+// NewInterval. Any other combination is marshalled using bracket notation, where `[` and `]` are inclusive and `(` and
+// `)` are exclusive. For example, `[2020-04-01T14:00:00.000Z/2020-04-01T15:00:00.000Z]` includes both ends.
+//
+// Interval can also unmarshal an ISO 8601 interval of the form `start/duration` or `duration/end`.
+type Interval[P Precision] struct {
+	Start          Time[P]
+	End            Time[P]
+	StartInclusive bool
+	EndInclusive   bool
+}
+
+// NanoInterval is a dynamocity.Interval with fixed nanosecond precision
+type NanoInterval = Interval[NanoPrecision]
+
+// MicrosInterval is a dynamocity.Interval with fixed microsecond precision
+type MicrosInterval = Interval[MicrosPrecision]
+
+// MillisInterval is a dynamocity.Interval with fixed millisecond precision
+type MillisInterval = Interval[MillisPrecision]
+
+// SecondsInterval is a dynamocity.Interval with fixed second precision
+type SecondsInterval = Interval[SecondsPrecision]
+
+// NewInterval is a factory function for creating a half-open dynamocity.Interval, which includes the start and
+// excludes the end
+func NewInterval[P Precision](start, end Time[P]) Interval[P] {
+	return Interval[P]{
+		Start:          start,
+		End:            end,
+		StartInclusive: true,
+	}
+}
+
+// Contains reports whether the dynamocity.Time is within this dynamocity.Interval
+func (i Interval[P]) Contains(t Time[P]) bool {
+	switch {
+	case i.StartInclusive && i.EndInclusive:
+		return BetweenInclusiveOf(t, i.Start, i.End)
+	case i.StartInclusive:
+		return BetweenStartIncOf(t, i.Start, i.End)
+	case i.EndInclusive:
+		return BetweenEndIncOf(t, i.Start, i.End)
+	default:
+		return BetweenExclusiveOf(t, i.Start, i.End)
+	}
+}
+
+// IsEmpty reports whether this dynamocity.Interval does not contain any instant
+func (i Interval[P]) IsEmpty() bool {
+	if i.Start.Equal(i.End) {
+		return !(i.StartInclusive && i.EndInclusive)
+	}
+	return i.Start.After(i.End)
+}
+
+// IsZero reports whether both the Start and End of this dynamocity.Interval are zero
+func (i Interval[P]) IsZero() bool {
+	return i.Start.IsZero() && i.End.IsZero()
+}
+
+// Duration returns the dynamocity.Duration between the Start and End of this dynamocity.Interval
+func (i Interval[P]) Duration() Duration {
+	return i.End.Sub(i.Start)
+}
+
+// Overlaps reports whether this dynamocity.Interval and the other dynamocity.Interval have any instant in common
+func (i Interval[P]) Overlaps(other Interval[P]) bool {
+	_, ok := i.Intersect(other)
+	return ok
+}
+
+// Intersect returns the dynamocity.Interval which is contained by both this and the other dynamocity.Interval.
+// The result is only ok if the intersection is not empty
+func (i Interval[P]) Intersect(other Interval[P]) (Interval[P], bool) {
+	intersection := i
+	switch c := i.Start.Compare(other.Start); {
+	case c < 0:
+		intersection.Start, intersection.StartInclusive = other.Start, other.StartInclusive
+	case c == 0:
+		intersection.StartInclusive = i.StartInclusive && other.StartInclusive
+	}
+	switch c := i.End.Compare(other.End); {
+	case c > 0:
+		intersection.End, intersection.EndInclusive = other.End, other.EndInclusive
+	case c == 0:
+		intersection.EndInclusive = i.EndInclusive && other.EndInclusive
+	}
+	if intersection.IsEmpty() {
+		return Interval[P]{}, false
+	}
+	return intersection, true
+}
+
+// Union returns the dynamocity.Interval which contains both this and the other dynamocity.Interval. The result is only
+// ok if the intervals overlap or are adjacent, as otherwise the union is not a single dynamocity.Interval
+func (i Interval[P]) Union(other Interval[P]) (Interval[P], bool) {
+	if i.IsEmpty() {
+		return other, !other.IsEmpty()
+	}
+	if other.IsEmpty() {
+		return i, true
+	}
+	if !i.Overlaps(other) && !i.adjacent(other) && !other.adjacent(i) {
+		return Interval[P]{}, false
+	}
+	union := i
+	switch c := i.Start.Compare(other.Start); {
+	case c > 0:
+		union.Start, union.StartInclusive = other.Start, other.StartInclusive
+	case c == 0:
+		union.StartInclusive = i.StartInclusive || other.StartInclusive
+	}
+	switch c := i.End.Compare(other.End); {
+	case c < 0:
+		union.End, union.EndInclusive = other.End, other.EndInclusive
+	case c == 0:
+		union.EndInclusive = i.EndInclusive || other.EndInclusive
+	}
+	return union, true
+}
+
+// adjacent is a helper function to determine whether the End of this dynamocity.Interval meets the Start of the
+// other dynamocity.Interval, without a gap or an overlap
+func (i Interval[P]) adjacent(other Interval[P]) bool {
+	return i.End.Equal(other.Start) && (i.EndInclusive || other.StartInclusive)
+}
+
+// Split returns consecutive dynamocity.Interval values of the step Duration which together contain the same instants
+// as this dynamocity.Interval. Each split is half-open, except the first keeps StartInclusive and the last keeps
+// EndInclusive and may be shorter than the step. An empty dynamocity.Interval, or a step which is not a positive multiple
+// of the Resolution of P, returns nil; as a split boundary which cannot be marshalled would be truncated into its
+// neighbouring split
+func (i Interval[P]) Split(step Duration) []Interval[P] {
+	var p P
+	if step <= 0 || i.IsEmpty() {
+		return nil
+	}
+	if resolution := p.Resolution(); resolution > 0 && time.Duration(step)%resolution != 0 {
+		return nil
+	}
+	var splits []Interval[P]
+	start, startInclusive := i.Start, i.StartInclusive
+	for {
+		end := start.Add(step)
+		if !end.Before(i.End) || end.Before(start) {
+			return append(splits, Interval[P]{Start: start, End: i.End, StartInclusive: startInclusive, EndInclusive: i.EndInclusive})
+		}
+		splits = append(splits, Interval[P]{Start: start, End: end, StartInclusive: startInclusive})
+		start, startInclusive = end, true
+	}
+}
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal
+// a dynamocity.Interval into a DynamoDB AttributeValue string value as an ISO 8601 interval.
+func (i Interval[P]) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return i.attributeValue(), nil
+}
+
+// attributeValue is a helper function to marshal a dynamocity.Interval into a string AttributeValue
+func (i Interval[P]) attributeValue() types.AttributeValue {
+	return &types.AttributeValueMemberS{
+		Value: i.String(),
+	}
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal
+// a types.AttributeValue into a dynamocity.Interval. A NULL AttributeValue is unmarshalled as a zero dynamocity.Interval
+func (i *Interval[P]) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if isNull(av) {
+		*i = Interval[P]{}
+		return nil
+	}
+	tv, ok := av.(*types.AttributeValueMemberS)
+	if !ok {
+		return &attributevalue.UnmarshalTypeError{
+			Value: fmt.Sprintf("%T", av),
+			Type:  reflect.TypeOf((*Interval[P])(nil)),
+		}
+	}
+	parsed, err := ParseInterval[P](tv.Value)
+	if err != nil {
+		return err
+	}
+	*i = parsed
+	return nil
+}
+
+// String implements the fmt.Stringer interface to supply the ISO 8601 interval, using bracket notation unless this
+// dynamocity.Interval is half-open
+func (i Interval[P]) String() string {
+	interval := i.Start.String() + "/" + i.End.String()
+	if i.StartInclusive && !i.EndInclusive {
+		return interval
+	}
+	open, close := "(", ")"
+	if i.StartInclusive {
+		open = "["
+	}
+	if i.EndInclusive {
+		close = "]"
+	}
+	return open + interval + close
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal an ISO 8601 interval. A JSON null is a no-op
+func (i *Interval[P]) UnmarshalJSON(b []byte) error {
+	if isJSONNull(b) {
+		return nil
+	}
+	str, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	parsed, err := ParseInterval[P](str)
+	if err != nil {
+		return err
+	}
+	*i = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal an ISO 8601 interval with the fixed precision of P
+func (i Interval[P]) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(i.String())), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface to unmarshal an ISO 8601 interval
+func (i *Interval[P]) UnmarshalText(b []byte) error {
+	parsed, err := ParseInterval[P](string(b))
+	if err != nil {
+		return err
+	}
+	*i = parsed
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface to marshal an ISO 8601 interval with the fixed precision of P
+func (i Interval[P]) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// ParseInterval will attempt to parse an ISO 8601 interval of the form `start/end`, `start/duration` or `duration/end`
+// to a dynamocity.Interval, where each Timestamp is parsed according to the Precision P. A plain interval is half-open,
+// otherwise the inclusivity of each end is supplied using bracket notation
+func ParseInterval[P Precision](str string) (Interval[P], error) {
+	interval := Interval[P]{StartInclusive: true}
+	body := str
+	if strings.HasPrefix(body, "[") || strings.HasPrefix(body, "(") {
+		if !strings.HasSuffix(body, "]") && !strings.HasSuffix(body, ")") {
+			return Interval[P]{}, fmt.Errorf("Interval '%s' has an unmatched bracket", str)
+		}
+		interval.StartInclusive = body[0] == '['
+		interval.EndInclusive = body[len(body)-1] == ']'
+		body = body[1 : len(body)-1]
+	}
+
+	start, end, ok := strings.Cut(body, "/")
+	if !ok {
+		return Interval[P]{}, fmt.Errorf("Interval '%s' must be of the form start/end", str)
+	}
+
+	startDuration, startIsDuration := parseISODuration(start)
+	endDuration, endIsDuration := parseISODuration(end)
+	switch {
+	case startIsDuration && endIsDuration:
+		return Interval[P]{}, fmt.Errorf("Interval '%s' must include a Timestamp", str)
+	case startIsDuration:
+		parsedEnd, err := parseTime[P](end)
+		if err != nil {
+			return Interval[P]{}, err
+		}
+		interval.End = Time[P](parsedEnd)
+		interval.Start = interval.End.Add(-startDuration)
+	case endIsDuration:
+		parsedStart, err := parseTime[P](start)
+		if err != nil {
+			return Interval[P]{}, err
+		}
+		interval.Start = Time[P](parsedStart)
+		interval.End = interval.Start.Add(endDuration)
+	default:
+		parsedStart, err := parseTime[P](start)
+		if err != nil {
+			return Interval[P]{}, err
+		}
+		parsedEnd, err := parseTime[P](end)
+		if err != nil {
+			return Interval[P]{}, err
+		}
+		interval.Start, interval.End = Time[P](parsedStart), Time[P](parsedEnd)
+	}
+	return interval, nil
+}
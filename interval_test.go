@@ -0,0 +1,235 @@
+package dynamocity_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/edwardsmatt/dynamocity"
+)
+
+// millisAt is a helper function to create a dynamocity.MillisTime on 2020-04-01 at the hour and minute in UTC
+func millisAt(hour, min int) dynamocity.MillisTime {
+	return dynamocity.MillisTime(time.Date(2020, time.April, 1, hour, min, 0, 0, time.UTC))
+}
+
+func Test_IntervalContains(t *testing.T) {
+	cases := []struct {
+		name     string
+		interval dynamocity.MillisInterval
+		input    dynamocity.MillisTime
+		expected bool
+	}{
+		{name: "Given a half-open interval, when at the start, then contains", interval: dynamocity.NewInterval(millisAt(14, 0), millisAt(15, 0)), input: millisAt(14, 0), expected: true},
+		{name: "Given a half-open interval, when at the end, then does not contain", interval: dynamocity.NewInterval(millisAt(14, 0), millisAt(15, 0)), input: millisAt(15, 0), expected: false},
+		{name: "Given an inclusive interval, when at the end, then contains", interval: dynamocity.MillisInterval{Start: millisAt(14, 0), End: millisAt(15, 0), StartInclusive: true, EndInclusive: true}, input: millisAt(15, 0), expected: true},
+		{name: "Given an exclusive interval, when at the start, then does not contain", interval: dynamocity.MillisInterval{Start: millisAt(14, 0), End: millisAt(15, 0)}, input: millisAt(14, 0), expected: false},
+		{name: "Given an end inclusive interval, when at the end, then contains", interval: dynamocity.MillisInterval{Start: millisAt(14, 0), End: millisAt(15, 0), EndInclusive: true}, input: millisAt(15, 0), expected: true},
+		{name: "Given any interval, when within, then contains", interval: dynamocity.MillisInterval{Start: millisAt(14, 0), End: millisAt(15, 0)}, input: millisAt(14, 30), expected: true},
+	}
+
+	for _, tc := range cases {
+		if actual := tc.interval.Contains(tc.input); actual != tc.expected {
+			t.Errorf("%s. Expected '%t', Got '%t'", tc.name, tc.expected, actual)
+		}
+	}
+}
+
+func Test_IntervalIntersectAndUnion(t *testing.T) {
+	cases := []struct {
+		name              string
+		a                 dynamocity.MillisInterval
+		b                 dynamocity.MillisInterval
+		expectedOverlaps  bool
+		expectedIntersect string
+		expectedUnion     string
+		expectedUnionOk   bool
+	}{
+		{
+			name:              "Given overlapping intervals, then intersect the overlap and union the extent",
+			a:                 dynamocity.NewInterval(millisAt(14, 0), millisAt(15, 0)),
+			b:                 dynamocity.NewInterval(millisAt(14, 30), millisAt(16, 0)),
+			expectedOverlaps:  true,
+			expectedIntersect: "2020-04-01T14:30:00.000Z/2020-04-01T15:00:00.000Z",
+			expectedUnion:     "2020-04-01T14:00:00.000Z/2020-04-01T16:00:00.000Z",
+			expectedUnionOk:   true,
+		},
+		{
+			name:            "Given adjacent half-open intervals, then do not overlap; however, union",
+			a:               dynamocity.NewInterval(millisAt(14, 0), millisAt(15, 0)),
+			b:               dynamocity.NewInterval(millisAt(15, 0), millisAt(16, 0)),
+			expectedUnion:   "2020-04-01T14:00:00.000Z/2020-04-01T16:00:00.000Z",
+			expectedUnionOk: true,
+		},
+		{
+			name:              "Given intervals which share an inclusive end, then intersect at a single instant",
+			a:                 dynamocity.MillisInterval{Start: millisAt(14, 0), End: millisAt(15, 0), StartInclusive: true, EndInclusive: true},
+			b:                 dynamocity.MillisInterval{Start: millisAt(15, 0), End: millisAt(16, 0), StartInclusive: true, EndInclusive: true},
+			expectedOverlaps:  true,
+			expectedIntersect: "[2020-04-01T15:00:00.000Z/2020-04-01T15:00:00.000Z]",
+			expectedUnion:     "[2020-04-01T14:00:00.000Z/2020-04-01T16:00:00.000Z]",
+			expectedUnionOk:   true,
+		},
+		{
+			name: "Given intervals which are both open at the same instant, then do not union",
+			a:    dynamocity.MillisInterval{Start: millisAt(14, 0), End: millisAt(15, 0), StartInclusive: true},
+			b:    dynamocity.MillisInterval{Start: millisAt(15, 0), End: millisAt(16, 0)},
+		},
+		{
+			name: "Given disjoint intervals, then neither intersect nor union",
+			a:    dynamocity.NewInterval(millisAt(14, 0), millisAt(15, 0)),
+			b:    dynamocity.NewInterval(millisAt(16, 0), millisAt(17, 0)),
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := tc.a.Overlaps(tc.b); actual != tc.expectedOverlaps {
+			t.Errorf("%s. Expected Overlaps '%t', Got '%t'", tc.name, tc.expectedOverlaps, actual)
+		}
+		if actual := tc.b.Overlaps(tc.a); actual != tc.expectedOverlaps {
+			t.Errorf("%s. Expected symmetric Overlaps '%t', Got '%t'", tc.name, tc.expectedOverlaps, actual)
+		}
+		if intersection, ok := tc.a.Intersect(tc.b); ok && intersection.String() != tc.expectedIntersect {
+			t.Errorf("%s. Expected Intersect '%s', Got '%s'", tc.name, tc.expectedIntersect, intersection)
+		}
+		union, ok := tc.a.Union(tc.b)
+		if ok != tc.expectedUnionOk {
+			t.Errorf("%s. Expected Union ok '%t', Got '%t'", tc.name, tc.expectedUnionOk, ok)
+			continue
+		}
+		if ok && union.String() != tc.expectedUnion {
+			t.Errorf("%s. Expected Union '%s', Got '%s'", tc.name, tc.expectedUnion, union)
+		}
+		if reversed, _ := tc.b.Union(tc.a); ok && reversed.String() != tc.expectedUnion {
+			t.Errorf("%s. Expected symmetric Union '%s', Got '%s'", tc.name, tc.expectedUnion, reversed)
+		}
+	}
+}
+
+func Test_IntervalSplit(t *testing.T) {
+	interval := dynamocity.MillisInterval{Start: millisAt(14, 0), End: millisAt(15, 30), StartInclusive: true, EndInclusive: true}
+
+	splits := interval.Split(dynamocity.Duration(30 * time.Minute))
+	expected := []string{
+		"2020-04-01T14:00:00.000Z/2020-04-01T14:30:00.000Z",
+		"2020-04-01T14:30:00.000Z/2020-04-01T15:00:00.000Z",
+		"[2020-04-01T15:00:00.000Z/2020-04-01T15:30:00.000Z]",
+	}
+	if len(splits) != len(expected) {
+		t.Errorf("Unexpected number of splits. Expected '%d', Got '%d'", len(expected), len(splits))
+		t.FailNow()
+	}
+	for i, split := range splits {
+		if split.String() != expected[i] {
+			t.Errorf("Unexpected split %d. Expected '%s', Got '%s'", i, expected[i], split)
+		}
+	}
+
+	uneven := dynamocity.NewInterval(millisAt(14, 0), millisAt(14, 45)).Split(dynamocity.Duration(30 * time.Minute))
+	if len(uneven) != 2 || uneven[1].String() != "2020-04-01T14:30:00.000Z/2020-04-01T14:45:00.000Z" {
+		t.Errorf("Expected the last split to be shorter than the step. Got '%v'", uneven)
+	}
+
+	if splits := interval.Split(0); splits != nil {
+		t.Errorf("Expected no splits for a zero step. Got '%v'", splits)
+	}
+	if splits := interval.Split(dynamocity.Duration(30*time.Minute + time.Microsecond)); splits != nil {
+		t.Errorf("Expected no splits for a step which is not a multiple of the precision. Got '%v'", splits)
+	}
+	seconds := dynamocity.NewInterval(dynamocity.SecondsTime(millisAt(14, 0)), dynamocity.SecondsTime(millisAt(14, 1)))
+	if splits := seconds.Split(dynamocity.Duration(1500 * time.Millisecond)); splits != nil {
+		t.Errorf("Expected no splits for a sub-second step of a dynamocity.SecondsInterval. Got '%v'", splits)
+	}
+}
+
+func Test_IntervalMarshalling(t *testing.T) {
+	type TestType struct {
+		Window dynamocity.MillisInterval `dynamodbav:"window" json:"window"`
+	}
+
+	cases := []struct {
+		name     string
+		interval dynamocity.MillisInterval
+		expected string
+	}{
+		{name: "Given a half-open interval, then marshal a plain ISO 8601 interval", interval: dynamocity.NewInterval(millisAt(14, 0), millisAt(15, 0)), expected: "2020-04-01T14:00:00.000Z/2020-04-01T15:00:00.000Z"},
+		{name: "Given an inclusive interval, then marshal with square brackets", interval: dynamocity.MillisInterval{Start: millisAt(14, 0), End: millisAt(15, 0), StartInclusive: true, EndInclusive: true}, expected: "[2020-04-01T14:00:00.000Z/2020-04-01T15:00:00.000Z]"},
+		{name: "Given an exclusive interval, then marshal with round brackets", interval: dynamocity.MillisInterval{Start: millisAt(14, 0), End: millisAt(15, 0)}, expected: "(2020-04-01T14:00:00.000Z/2020-04-01T15:00:00.000Z)"},
+	}
+
+	for _, tc := range cases {
+		item, err := attributevalue.MarshalMap(TestType{Window: tc.interval})
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if actual := decodeAttributeValue(item["window"], t); actual != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, actual)
+		}
+		var fromDynamo TestType
+		if err := attributevalue.UnmarshalMap(item, &fromDynamo); err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if fromDynamo.Window.String() != tc.expected || fromDynamo.Window.StartInclusive != tc.interval.StartInclusive || fromDynamo.Window.EndInclusive != tc.interval.EndInclusive {
+			t.Errorf("%s. Unexpected interval after attribute value round trip. Got '%s'", tc.name, fromDynamo.Window)
+		}
+
+		jsonBytes, err := json.Marshal(TestType{Window: tc.interval})
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		var fromJSON TestType
+		if err := json.Unmarshal(jsonBytes, &fromJSON); err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if fromJSON.Window.String() != tc.expected {
+			t.Errorf("%s. Unexpected interval after JSON round trip. Expected '%s', Got '%s'", tc.name, tc.expected, fromJSON.Window)
+		}
+	}
+
+	av, err := attributevalue.Marshal(dynamocity.MillisInterval{})
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if zero := decodeAttributeValue(av, t); zero != "(0001-01-01T00:00:00.000Z/0001-01-01T00:00:00.000Z)" {
+		t.Errorf("Expected a zero dynamocity.Interval to marshal the zero interval. Got '%s'", zero)
+	}
+}
+
+func Test_IntervalParsing(t *testing.T) {
+	cases := []struct {
+		name        string
+		input       string
+		expected    string
+		expectedErr bool
+	}{
+		{name: "Given a start and duration, then parse", input: "2020-04-01T14:00:00Z/PT1H", expected: "2020-04-01T14:00:00.000Z/2020-04-01T15:00:00.000Z"},
+		{name: "Given a duration and end, then parse", input: "PT30M/2020-04-01T15:00:00Z", expected: "2020-04-01T14:30:00.000Z/2020-04-01T15:00:00.000Z"},
+		{name: "Given flexible precision, then parse", input: "(2020-04-01T14:00:00.1Z/2020-04-01T15:00:00+01:00]", expected: "(2020-04-01T14:00:00.100Z/2020-04-01T14:00:00.000Z]"},
+		{name: "Given two durations, then error", input: "PT1H/PT2H", expectedErr: true},
+		{name: "Given no separator, then error", input: "2020-04-01T14:00:00Z", expectedErr: true},
+		{name: "Given an unmatched bracket, then error", input: "[2020-04-01T14:00:00Z/2020-04-01T15:00:00Z", expectedErr: true},
+	}
+
+	for _, tc := range cases {
+		interval, err := dynamocity.ParseInterval[dynamocity.MillisPrecision](tc.input)
+		if tc.expectedErr {
+			if err == nil {
+				t.Errorf("%s. Expected an error, Got '%s'", tc.name, interval)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if interval.String() != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, interval)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package dynamocity
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+)
+
+// KeyCondition returns an expression.KeyConditionBuilder which selects the values of the sort key name which this
+// dynamocity.Interval Contains, where the sort key is marshalled with the fixed precision of P.
+//
+// As DynamoDB's BETWEEN is inclusive of both ends, an exclusive Start is adjusted to the next representable value at
+// the Resolution of P, and an exclusive End to the previous representable value. For example, the half-open
+// MillisInterval `2020-04-01T14:00:00.000Z/2020-04-01T15:00:00.000Z` selects
+// `BETWEEN 2020-04-01T14:00:00.000Z AND 2020-04-01T14:59:59.999Z`. An error is returned if the dynamocity.Interval
+// does not contain any representable value, as DynamoDB rejects a BETWEEN with a lower bound greater than its upper bound.
+//
+// The bounds are always formatted in UTC, even with a dynamocity.Offset precision, as per the partition keys of a
+// BucketStrategy, so that they compare lexicographically with sort keys marshalled in UTC. Sort keys which were
+// marshalled with an offset are not supported
+func (i Interval[P]) KeyCondition(name string) (expression.KeyConditionBuilder, error) {
+	lower, upper, ok := i.bounds()
+	if !ok {
+		return expression.KeyConditionBuilder{}, fmt.Errorf("Interval '%s' does not contain any value with the precision of the sort key", i)
+	}
+	return expression.Key(name).Between(expression.Value(lower.utcString()), expression.Value(upper.utcString())), nil
+}
+
+// BetweenKeyCondition returns an expression.KeyConditionBuilder which selects the values of the sort key name between
+// start and end, with the supplied inclusivity of each end. The values selected are the same as those accepted in
+// memory by BetweenStartIncOf, BetweenEndIncOf, BetweenExclusiveOf or BetweenInclusiveOf, as per dynamocity.Interval KeyCondition
+func BetweenKeyCondition[P Precision](name string, start Time[P], startInclusive bool, end Time[P], endInclusive bool) (expression.KeyConditionBuilder, error) {
+	return Interval[P]{
+		Start:          start,
+		End:            end,
+		StartInclusive: startInclusive,
+		EndInclusive:   endInclusive,
+	}.KeyCondition(name)
+}
+
+// bounds is a helper function to return the inclusive lower and upper bounds of this dynamocity.Interval at the
+// Resolution of P. The result is only ok if the lower bound is not after the upper bound
+func (i Interval[P]) bounds() (Time[P], Time[P], bool) {
+	var p P
+	lower, upper := i.Start.Truncate(), i.End.Truncate()
+	if !i.StartInclusive {
+		lower = Time[P](lower.Time().Add(p.Resolution()))
+	}
+	if !i.EndInclusive {
+		upper = Time[P](upper.Time().Add(-p.Resolution()))
+	}
+	return lower, upper, !lower.After(upper)
+}
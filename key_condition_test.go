@@ -0,0 +1,116 @@
+package dynamocity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/edwardsmatt/dynamocity"
+)
+
+// keyConditionBounds is a helper function to build a KeyConditionBuilder and return its lower and upper values
+func keyConditionBounds(keyCondition expression.KeyConditionBuilder, t *testing.T) (string, string) {
+	expr, err := expression.NewBuilder().WithKeyCondition(keyCondition).Build()
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if *expr.KeyCondition() != "#0 BETWEEN :0 AND :1" || expr.Names()["#0"] != "sk" {
+		t.Errorf("Unexpected KeyCondition '%s' with names '%v'", *expr.KeyCondition(), expr.Names())
+	}
+	return decodeAttributeValue(expr.Values()[":0"], t), decodeAttributeValue(expr.Values()[":1"], t)
+}
+
+func Test_IntervalKeyCondition(t *testing.T) {
+	start := millisAt(14, 0)
+	end := millisAt(15, 0)
+
+	cases := []struct {
+		name           string
+		startInclusive bool
+		endInclusive   bool
+		expectedLower  string
+		expectedUpper  string
+	}{
+		{name: "Given BetweenStartInc semantics, then adjust the end", startInclusive: true, expectedLower: "2020-04-01T14:00:00.000Z", expectedUpper: "2020-04-01T14:59:59.999Z"},
+		{name: "Given BetweenEndInc semantics, then adjust the start", endInclusive: true, expectedLower: "2020-04-01T14:00:00.001Z", expectedUpper: "2020-04-01T15:00:00.000Z"},
+		{name: "Given BetweenExclusive semantics, then adjust both ends", expectedLower: "2020-04-01T14:00:00.001Z", expectedUpper: "2020-04-01T14:59:59.999Z"},
+		{name: "Given BetweenInclusive semantics, then keep both ends", startInclusive: true, endInclusive: true, expectedLower: "2020-04-01T14:00:00.000Z", expectedUpper: "2020-04-01T15:00:00.000Z"},
+	}
+
+	for _, tc := range cases {
+		keyCondition, err := dynamocity.BetweenKeyCondition("sk", start, tc.startInclusive, end, tc.endInclusive)
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		lower, upper := keyConditionBounds(keyCondition, t)
+		if lower != tc.expectedLower || upper != tc.expectedUpper {
+			t.Errorf("%s. Expected '%s' to '%s', Got '%s' to '%s'", tc.name, tc.expectedLower, tc.expectedUpper, lower, upper)
+		}
+
+		interval := dynamocity.MillisInterval{Start: start, End: end, StartInclusive: tc.startInclusive, EndInclusive: tc.endInclusive}
+		for _, offset := range []time.Duration{-time.Millisecond, -time.Microsecond, 0, time.Microsecond, time.Millisecond} {
+			for _, boundary := range []dynamocity.MillisTime{start, end} {
+				candidate := dynamocity.MillisTime(boundary.Time().Add(offset))
+				selected := candidate.String() >= lower && candidate.String() <= upper
+				if selected != interval.Contains(candidate) {
+					t.Errorf("%s. Expected the KeyCondition to select '%v' only if the Interval contains it. Got '%t'", tc.name, candidate.Time(), selected)
+				}
+			}
+		}
+	}
+}
+
+func Test_IntervalKeyConditionPrecision(t *testing.T) {
+	start := time.Date(2020, time.April, 1, 14, 0, 0, 500, time.UTC)
+	end := time.Date(2020, time.April, 2, 1, 0, 0, 0, time.FixedZone("AEST", 10*60*60))
+
+	seconds, err := dynamocity.NewInterval(dynamocity.SecondsTime(start), dynamocity.SecondsTime(end)).KeyCondition("sk")
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if lower, upper := keyConditionBounds(seconds, t); lower != "2020-04-01T14:00:00Z" || upper != "2020-04-01T14:59:59Z" {
+		t.Errorf("Expected UTC bounds at second precision. Got '%s' to '%s'", lower, upper)
+	}
+
+	nanos, err := dynamocity.NanoInterval{Start: dynamocity.NanoTime(start), End: dynamocity.NanoTime(start.Add(2 * time.Nanosecond))}.KeyCondition("sk")
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if lower, upper := keyConditionBounds(nanos, t); lower != "2020-04-01T14:00:00.000000501Z" || upper != lower {
+		t.Errorf("Expected a single nanosecond to be selected. Got '%s' to '%s'", lower, upper)
+	}
+
+	cases := []struct {
+		name     string
+		interval dynamocity.MillisInterval
+	}{
+		{name: "Given an exclusive interval of one millisecond, then error", interval: dynamocity.MillisInterval{Start: millisAt(14, 0), End: dynamocity.MillisTime(millisAt(14, 0).Time().Add(time.Millisecond))}},
+		{name: "Given an interval within a millisecond, then error", interval: dynamocity.NewInterval(millisAt(14, 0), dynamocity.MillisTime(millisAt(14, 0).Time().Add(time.Microsecond)))},
+		{name: "Given an end before the start, then error", interval: dynamocity.NewInterval(millisAt(15, 0), millisAt(14, 0))},
+	}
+
+	for _, tc := range cases {
+		if _, err := tc.interval.KeyCondition("sk"); err == nil {
+			t.Errorf("%s. Expected an error", tc.name)
+		}
+	}
+}
+
+func Test_IntervalKeyConditionOffset(t *testing.T) {
+	aest := time.FixedZone("AEST", 10*60*60)
+	start := dynamocity.OffsetMillisTime(time.Date(2020, time.April, 2, 0, 0, 0, 0, aest))
+	end := dynamocity.OffsetMillisTime(time.Date(2020, time.April, 2, 1, 0, 0, 0, aest))
+
+	keyCondition, err := dynamocity.NewInterval(start, end).KeyCondition("sk")
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if lower, upper := keyConditionBounds(keyCondition, t); lower != "2020-04-01T14:00:00.000Z" || upper != "2020-04-01T14:59:59.999Z" {
+		t.Errorf("Expected UTC bounds regardless of an Offset precision. Got '%s' to '%s'", lower, upper)
+	}
+}
@@ -0,0 +1,316 @@
+package dynamocity
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// EpochUnit is the unit of a Timestamp which has been stored as a number offset from the Unix epoch
+type EpochUnit int
+
+const (
+	// EpochUnitAuto detects the unit of an epoch number from its magnitude. Numbers with fewer than 12 digits are
+	// seconds, fewer than 15 digits are milliseconds, fewer than 18 digits are microseconds, otherwise nanoseconds.
+	// A number with a fractional part is always seconds.
+	EpochUnitAuto EpochUnit = iota
+	// EpochUnitSeconds is an epoch number of seconds, which may have a fractional part
+	EpochUnitSeconds
+	// EpochUnitMillis is an epoch number of milliseconds
+	EpochUnitMillis
+	// EpochUnitMicros is an epoch number of microseconds
+	EpochUnitMicros
+	// EpochUnitNanos is an epoch number of nanoseconds
+	EpochUnitNanos
+)
+
+// LegacyLayouts are common Timestamp layouts which are not RFC3339; however, may be found in legacy data.
+// A layout without a zone offset is parsed as UTC.
+var LegacyLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC850,
+	time.UnixDate,
+}
+
+// legacyTarget is implemented by the dynamocity types which a LegacyDecoder can decode a legacy value into
+type legacyTarget interface {
+	acceptsLegacy() bool
+}
+
+// LegacyDecoder supplies the legacy formats which a dynamocity.Time or a dynamocity.Date will be unmarshalled from, in
+// addition to an RFC3339 Timestamp. This allows a struct to use the dynamocity types before existing data has been migrated.
+//
+// A Number AttributeValue is decoded as an epoch in the Unit of the LegacyDecoder, and a string which is not an RFC3339
+// Timestamp is parsed with each of the Layouts. A LegacyDecoder is never applied to a dynamocity.Strict precision, and
+// unmarshalling with the attributevalue package directly only accepts RFC3339 Timestamps (or dates for a dynamocity.Date).
+//
+// For example, to accept epoch seconds or milliseconds and the LegacyLayouts:
+//
+//	decoder := dynamocity.LegacyDecoder{Unit: dynamocity.EpochUnitAuto, Layouts: dynamocity.LegacyLayouts}
+//	err := decoder.UnmarshalMap(output.Item, &item)
+type LegacyDecoder struct {
+	// Unit is the unit of a Number AttributeValue
+	Unit EpochUnit
+	// Layouts are attempted in order to parse a string which is not an RFC3339 Timestamp
+	Layouts []string
+}
+
+// Unmarshal will unmarshal a types.AttributeValue into out in the same way as attributevalue.Unmarshal; however, a
+// legacy value for any dynamocity.Time or dynamocity.Date within out is first decoded with this LegacyDecoder.
+// Struct fields are matched by their `dynamodbav` tag, as they are by default in the attributevalue package
+func (d LegacyDecoder) Unmarshal(av types.AttributeValue, out interface{}) error {
+	v := reflect.ValueOf(out)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return attributevalue.Unmarshal(av, out)
+	}
+	decoded, err := d.decodeLegacy(av, v.Type().Elem())
+	if err != nil {
+		return err
+	}
+	return attributevalue.Unmarshal(decoded, out)
+}
+
+// UnmarshalMap will unmarshal a map of types.AttributeValue into out in the same way as attributevalue.UnmarshalMap,
+// after first decoding any legacy value with this LegacyDecoder
+func (d LegacyDecoder) UnmarshalMap(m map[string]types.AttributeValue, out interface{}) error {
+	return d.Unmarshal(&types.AttributeValueMemberM{Value: m}, out)
+}
+
+// UnmarshalListOfMaps will unmarshal a slice of types.AttributeValue maps into out in the same way as
+// attributevalue.UnmarshalListOfMaps, after first decoding any legacy value with this LegacyDecoder
+func (d LegacyDecoder) UnmarshalListOfMaps(l []map[string]types.AttributeValue, out interface{}) error {
+	items := make([]types.AttributeValue, len(l))
+	for i, m := range l {
+		items[i] = &types.AttributeValueMemberM{Value: m}
+	}
+	return d.Unmarshal(&types.AttributeValueMemberL{Value: items}, out)
+}
+
+// Decode will attempt to decode a types.AttributeValue string or number to a time.Time
+func (d LegacyDecoder) Decode(av types.AttributeValue) (time.Time, error) {
+	switch tv := av.(type) {
+	case *types.AttributeValueMemberN:
+		return d.ParseNumber(tv.Value)
+	case *types.AttributeValueMemberS:
+		return d.Parse(tv.Value)
+	default:
+		return time.Time{}, fmt.Errorf("AttributeValue of type %T cannot be decoded as a legacy timestamp", av)
+	}
+}
+
+// Parse will attempt to parse a string with dynamocity.FlexibleNanoFmt, and then each of the Layouts in order
+func (d LegacyDecoder) Parse(str string) (time.Time, error) {
+	if parsedTime, err := time.Parse(FlexibleNanoFmt, str); err == nil {
+		return parsedTime, nil
+	}
+	for _, l := range d.Layouts {
+		if parsedTime, err := time.Parse(l, str); err == nil {
+			return parsedTime, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("Timestamp '%s' does not match any legacy layout", str)
+}
+
+// ParseNumber will attempt to parse a number string as an offset from the Unix epoch in the Unit of the LegacyDecoder
+func (d LegacyDecoder) ParseNumber(str string) (time.Time, error) {
+	integer, fraction, hasFraction := strings.Cut(str, ".")
+	epoch, err := strconv.ParseInt(integer, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Epoch '%s' cannot be unmarshalled as a valid number", str)
+	}
+
+	unit := d.Unit
+	if unit == EpochUnitAuto {
+		unit = detectEpochUnit(epoch, hasFraction)
+	}
+
+	switch unit {
+	case EpochUnitSeconds:
+		nanos, err := parseFraction(fraction)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("Epoch '%s' cannot be unmarshalled as a valid number", str)
+		}
+		if strings.HasPrefix(integer, "-") {
+			nanos = -nanos
+		}
+		return time.Unix(epoch, nanos).UTC(), nil
+	case EpochUnitMillis, EpochUnitMicros, EpochUnitNanos:
+		if hasFraction {
+			return time.Time{}, fmt.Errorf("Epoch '%s' must be an integer", str)
+		}
+		switch unit {
+		case EpochUnitMillis:
+			return time.UnixMilli(epoch).UTC(), nil
+		case EpochUnitMicros:
+			return time.UnixMicro(epoch).UTC(), nil
+		default:
+			return time.Unix(0, epoch).UTC(), nil
+		}
+	default:
+		return time.Time{}, fmt.Errorf("EpochUnit '%d' is not supported", unit)
+	}
+}
+
+// detectEpochUnit is a helper function to determine the EpochUnit of an epoch from its magnitude
+func detectEpochUnit(epoch int64, hasFraction bool) EpochUnit {
+	magnitude := math.Abs(float64(epoch))
+	switch {
+	case hasFraction || magnitude < 1e11:
+		return EpochUnitSeconds
+	case magnitude < 1e14:
+		return EpochUnitMillis
+	case magnitude < 1e17:
+		return EpochUnitMicros
+	default:
+		return EpochUnitNanos
+	}
+}
+
+// parseFraction is a helper function to parse up to nine digits of fractional seconds as nanoseconds
+func parseFraction(fraction string) (int64, error) {
+	if len(fraction) == 0 {
+		return 0, nil
+	}
+	if len(fraction) > 9 {
+		fraction = fraction[:9]
+	}
+	nanos, err := strconv.ParseUint(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+	return int64(nanos), err
+}
+
+// decodeLegacy is a helper function to return a copy of a types.AttributeValue which will be unmarshalled into the type t,
+// where each legacy value for a dynamocity.Time or dynamocity.Date has been replaced with an RFC3339 Timestamp
+func (d LegacyDecoder) decodeLegacy(av types.AttributeValue, t reflect.Type) (types.AttributeValue, error) {
+	if target, ok := reflect.New(t).Interface().(legacyTarget); ok {
+		if !target.acceptsLegacy() {
+			return av, nil
+		}
+		return d.decodeLegacyTime(av)
+	}
+	if _, ok := reflect.New(t).Interface().(attributevalue.Unmarshaler); ok {
+		return av, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return d.decodeLegacy(av, t.Elem())
+	case reflect.Slice, reflect.Array:
+		tv, ok := av.(*types.AttributeValueMemberL)
+		if !ok {
+			return av, nil
+		}
+		decoded := make([]types.AttributeValue, len(tv.Value))
+		for i, elem := range tv.Value {
+			decodedElem, err := d.decodeLegacy(elem, t.Elem())
+			if err != nil {
+				return nil, err
+			}
+			decoded[i] = decodedElem
+		}
+		return &types.AttributeValueMemberL{Value: decoded}, nil
+	case reflect.Map:
+		tv, ok := av.(*types.AttributeValueMemberM)
+		if !ok {
+			return av, nil
+		}
+		decoded := make(map[string]types.AttributeValue, len(tv.Value))
+		for key, elem := range tv.Value {
+			decodedElem, err := d.decodeLegacy(elem, t.Elem())
+			if err != nil {
+				return nil, err
+			}
+			decoded[key] = decodedElem
+		}
+		return &types.AttributeValueMemberM{Value: decoded}, nil
+	case reflect.Struct:
+		tv, ok := av.(*types.AttributeValueMemberM)
+		if !ok {
+			return av, nil
+		}
+		decoded := make(map[string]types.AttributeValue, len(tv.Value))
+		for key, elem := range tv.Value {
+			decoded[key] = elem
+		}
+		if err := d.decodeLegacyFields(decoded, t); err != nil {
+			return nil, err
+		}
+		return &types.AttributeValueMemberM{Value: decoded}, nil
+	default:
+		return av, nil
+	}
+}
+
+// decodeLegacyFields is a helper function to decode the legacy value of each field of the struct type t, including the
+// fields of embedded structs, which is found in the map of types.AttributeValue
+func (d LegacyDecoder) decodeLegacyFields(m map[string]types.AttributeValue, t reflect.Type) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("dynamodbav")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			if _, ok := reflect.New(fieldType).Interface().(attributevalue.Unmarshaler); !ok {
+				if err := d.decodeLegacyFields(m, fieldType); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		av, ok := m[name]
+		if !ok {
+			continue
+		}
+		decoded, err := d.decodeLegacy(av, field.Type)
+		if err != nil {
+			return err
+		}
+		m[name] = decoded
+	}
+	return nil
+}
+
+// decodeLegacyTime is a helper function to replace a legacy Number or string AttributeValue with an RFC3339 Timestamp.
+// A string which cannot be parsed is returned unchanged, so that the error is reported by the type being unmarshalled
+func (d LegacyDecoder) decodeLegacyTime(av types.AttributeValue) (types.AttributeValue, error) {
+	switch tv := av.(type) {
+	case *types.AttributeValueMemberN:
+		decoded, err := d.ParseNumber(tv.Value)
+		if err != nil {
+			return nil, err
+		}
+		return &types.AttributeValueMemberS{Value: decoded.Format(FlexibleNanoFmt)}, nil
+	case *types.AttributeValueMemberS:
+		decoded, err := d.Parse(tv.Value)
+		if err != nil {
+			return av, nil
+		}
+		return &types.AttributeValueMemberS{Value: decoded.Format(FlexibleNanoFmt)}, nil
+	default:
+		return av, nil
+	}
+}
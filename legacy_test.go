@@ -0,0 +1,196 @@
+package dynamocity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/edwardsmatt/dynamocity"
+)
+
+func Test_LegacyNotAppliedByAttributeValue(t *testing.T) {
+	var millisTime dynamocity.MillisTime
+	if err := attributevalue.Unmarshal(&types.AttributeValueMemberN{Value: "1585749600"}, &millisTime); err == nil {
+		t.Errorf("Expected an error when unmarshalling a number attribute value without a LegacyDecoder")
+	}
+	if err := attributevalue.Unmarshal(&types.AttributeValueMemberS{Value: "2020-04-01 14:00:00"}, &millisTime); err == nil {
+		t.Errorf("Expected an error when unmarshalling a legacy layout without a LegacyDecoder")
+	}
+}
+
+func Test_LegacyDecoding(t *testing.T) {
+	cases := []struct {
+		name     string
+		decoder  dynamocity.LegacyDecoder
+		av       types.AttributeValue
+		expected string
+	}{
+		{
+			name:     "Given epoch seconds, when detecting the unit, then decode as seconds",
+			decoder:  dynamocity.LegacyDecoder{Unit: dynamocity.EpochUnitAuto},
+			av:       &types.AttributeValueMemberN{Value: "1585749600"},
+			expected: "2020-04-01T14:00:00.000Z",
+		},
+		{
+			name:     "Given epoch milliseconds, when detecting the unit, then decode as milliseconds",
+			decoder:  dynamocity.LegacyDecoder{Unit: dynamocity.EpochUnitAuto},
+			av:       &types.AttributeValueMemberN{Value: "1585749600123"},
+			expected: "2020-04-01T14:00:00.123Z",
+		},
+		{
+			name:     "Given epoch microseconds, when detecting the unit, then decode as microseconds",
+			decoder:  dynamocity.LegacyDecoder{Unit: dynamocity.EpochUnitAuto},
+			av:       &types.AttributeValueMemberN{Value: "1585749600123456"},
+			expected: "2020-04-01T14:00:00.123Z",
+		},
+		{
+			name:     "Given epoch nanoseconds, when detecting the unit, then decode as nanoseconds",
+			decoder:  dynamocity.LegacyDecoder{Unit: dynamocity.EpochUnitAuto},
+			av:       &types.AttributeValueMemberN{Value: "1585749600123456789"},
+			expected: "2020-04-01T14:00:00.123Z",
+		},
+		{
+			name:     "Given fractional epoch seconds, when detecting the unit, then decode as seconds",
+			decoder:  dynamocity.LegacyDecoder{Unit: dynamocity.EpochUnitAuto},
+			av:       &types.AttributeValueMemberN{Value: "1585749600.5"},
+			expected: "2020-04-01T14:00:00.500Z",
+		},
+		{
+			name:     "Given a small number, when the unit is explicitly milliseconds, then decode as milliseconds",
+			decoder:  dynamocity.LegacyDecoder{Unit: dynamocity.EpochUnitMillis},
+			av:       &types.AttributeValueMemberN{Value: "1500"},
+			expected: "1970-01-01T00:00:01.500Z",
+		},
+		{
+			name:     "Given an RFC3339 Timestamp, then decode as normal",
+			decoder:  dynamocity.LegacyDecoder{},
+			av:       &types.AttributeValueMemberS{Value: "2020-04-01T14:00:00.1Z"},
+			expected: "2020-04-01T14:00:00.100Z",
+		},
+		{
+			name:     "Given a space separated Timestamp without a zone, when using the LegacyLayouts, then decode as UTC",
+			decoder:  dynamocity.LegacyDecoder{Layouts: dynamocity.LegacyLayouts},
+			av:       &types.AttributeValueMemberS{Value: "2020-04-01 14:00:00.25"},
+			expected: "2020-04-01T14:00:00.250Z",
+		},
+		{
+			name:     "Given an RFC1123Z Timestamp, when using the LegacyLayouts, then decode with the offset",
+			decoder:  dynamocity.LegacyDecoder{Layouts: dynamocity.LegacyLayouts},
+			av:       &types.AttributeValueMemberS{Value: "Thu, 02 Apr 2020 00:00:00 +1000"},
+			expected: "2020-04-01T14:00:00.000Z",
+		},
+	}
+
+	for _, tc := range cases {
+		var millisTime dynamocity.MillisTime
+		if err := tc.decoder.Unmarshal(tc.av, &millisTime); err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if millisTime.String() != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, millisTime)
+		}
+	}
+}
+
+func Test_LegacyDecodingDate(t *testing.T) {
+	decoder := dynamocity.LegacyDecoder{Unit: dynamocity.EpochUnitSeconds}
+
+	var date dynamocity.Date
+	if err := decoder.Unmarshal(&types.AttributeValueMemberN{Value: "1585749600"}, &date); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if date.String() != "2020-04-01" {
+		t.Errorf("Unexpected legacy date. Expected '%s', Got '%s'", "2020-04-01", date)
+	}
+}
+
+func Test_LegacyNotAppliedToStrict(t *testing.T) {
+	decoder := dynamocity.LegacyDecoder{Unit: dynamocity.EpochUnitAuto, Layouts: dynamocity.LegacyLayouts}
+
+	var strict dynamocity.StrictMillisTime
+	if err := decoder.Unmarshal(&types.AttributeValueMemberN{Value: "1585749600"}, &strict); err == nil {
+		t.Errorf("Expected an error when unmarshalling a number attribute value into a dynamocity.StrictMillisTime")
+	}
+	if err := decoder.Unmarshal(&types.AttributeValueMemberS{Value: "2020-04-01 14:00:00"}, &strict); err == nil {
+		t.Errorf("Expected an error when unmarshalling a legacy layout into a dynamocity.StrictMillisTime")
+	}
+}
+
+func Test_LegacyDecodingItems(t *testing.T) {
+	type Audit struct {
+		Updated dynamocity.MillisTime `dynamodbav:"updated"`
+	}
+	type Item struct {
+		Audit
+		Created     dynamocity.MillisTime            `dynamodbav:"created"`
+		Expires     *dynamocity.SecondsTime          `dynamodbav:"expires"`
+		Verified    dynamocity.NullMillisTime        `dynamodbav:"verified"`
+		Strict      dynamocity.StrictMillisTime      `dynamodbav:"strict"`
+		Occurrences []dynamocity.MillisTime          `dynamodbav:"occurrences"`
+		Deadlines   map[string]dynamocity.MillisTime `dynamodbav:"deadlines"`
+	}
+
+	item := map[string]types.AttributeValue{
+		"updated":  &types.AttributeValueMemberN{Value: "1585749600"},
+		"created":  &types.AttributeValueMemberS{Value: "2020-04-01 14:00:00"},
+		"expires":  &types.AttributeValueMemberN{Value: "1585749600123"},
+		"verified": &types.AttributeValueMemberN{Value: "1585749600"},
+		"strict":   &types.AttributeValueMemberS{Value: "2020-04-01T14:00:00.000Z"},
+		"occurrences": &types.AttributeValueMemberL{Value: []types.AttributeValue{
+			&types.AttributeValueMemberN{Value: "1585749600"},
+		}},
+		"deadlines": &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+			"review": &types.AttributeValueMemberN{Value: "1585749600"},
+		}},
+	}
+
+	decoder := dynamocity.LegacyDecoder{Unit: dynamocity.EpochUnitAuto, Layouts: dynamocity.LegacyLayouts}
+	var items []Item
+	if err := decoder.UnmarshalListOfMaps([]map[string]types.AttributeValue{item}, &items); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if len(items) != 1 {
+		t.Errorf("Expected 1 item, Got %d", len(items))
+		t.FailNow()
+	}
+
+	expected := "2020-04-01T14:00:00.000Z"
+	decoded := items[0]
+	actual := []string{
+		decoded.Updated.String(),
+		decoded.Created.String(),
+		dynamocity.MillisTime(decoded.Expires.Truncate()).String(),
+		decoded.Verified.V.String(),
+		decoded.Strict.String(),
+		decoded.Occurrences[0].String(),
+		decoded.Deadlines["review"].String(),
+	}
+	for _, a := range actual {
+		if a != expected {
+			t.Errorf("Unexpected legacy item value. Expected '%s', Got '%s'", expected, a)
+		}
+	}
+	if !decoded.Verified.Valid {
+		t.Errorf("Expected a legacy dynamocity.NullMillisTime to be valid")
+	}
+
+	if _, ok := item["updated"].(*types.AttributeValueMemberN); !ok {
+		t.Errorf("Expected the item being unmarshalled to be unchanged. Got %T", item["updated"])
+	}
+}
+
+func Test_LegacyParseNumberNegativeFraction(t *testing.T) {
+	decoded, err := dynamocity.LegacyDecoder{Unit: dynamocity.EpochUnitSeconds}.ParseNumber("-0.5")
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	expected := time.Unix(0, -500000000)
+	if !decoded.Equal(expected) {
+		t.Errorf("Unexpected decoded epoch. Expected '%v', Got '%v'", expected, decoded)
+	}
+}
@@ -0,0 +1,22 @@
+package dynamocity
+
+import "time"
+
+// MicrosPrecision is the Precision of a dynamocity.MicrosTime, which marshals using StrictMicrosFmt
+type MicrosPrecision struct{}
+
+// Layout implements the Precision interface to supply StrictMicrosFmt
+func (MicrosPrecision) Layout() string {
+	return StrictMicrosFmt
+}
+
+// Resolution implements the Precision interface to supply a resolution of a microsecond
+func (MicrosPrecision) Resolution() time.Duration {
+	return time.Microsecond
+}
+
+// MicrosTime represents a sortable strict RFC3339 Timestamp with fixed microsecond precision, making it string sortable.
+// MicrosTime implements attributevalue.Marshaler, attributevalue.Unmarshaller
+// The standard library time.RFC3339Nano format removes trailing zeros from the fractional seconds field
+// and thus may not sort correctly once formatted.
+type MicrosTime = Time[MicrosPrecision]
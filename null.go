@@ -0,0 +1,156 @@
+package dynamocity
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// nullable is implemented by the dynamocity types which can be wrapped by a dynamocity.Nullable
+type nullable interface {
+	IsZero() bool
+	attributeValue() types.AttributeValue
+}
+
+// Nullable represents a dynamocity type which may be unset, distinguishing an unset value from a zero value.
+//
+// Each dynamocity type marshals a zero value as its fixed width value, so that it remains valid as a key attribute.
+// A Nullable opts in to NULL: an invalid Nullable is marshalled as a NULL AttributeValue or a JSON null, whereas a
+// valid Nullable marshals V even when V is zero.
+// Nullable implements attributevalue.Marshaler, attributevalue.Unmarshaler, json.Marshaler and json.Unmarshaler
+type Nullable[T nullable] struct {
+	V     T
+	Valid bool
+}
+
+// NullNanoTime is a dynamocity.Nullable dynamocity.NanoTime
+type NullNanoTime = Nullable[NanoTime]
+
+// NullMicrosTime is a dynamocity.Nullable dynamocity.MicrosTime
+type NullMicrosTime = Nullable[MicrosTime]
+
+// NullMillisTime is a dynamocity.Nullable dynamocity.MillisTime
+type NullMillisTime = Nullable[MillisTime]
+
+// NullSecondsTime is a dynamocity.Nullable dynamocity.SecondsTime
+type NullSecondsTime = Nullable[SecondsTime]
+
+// NullDate is a dynamocity.Nullable dynamocity.Date
+type NullDate = Nullable[Date]
+
+// NullYearMonth is a dynamocity.Nullable dynamocity.YearMonth
+type NullYearMonth = Nullable[YearMonth]
+
+// NullYear is a dynamocity.Nullable dynamocity.Year
+type NullYear = Nullable[Year]
+
+// NullISOWeek is a dynamocity.Nullable dynamocity.ISOWeek
+type NullISOWeek = Nullable[ISOWeek]
+
+// NullQuarter is a dynamocity.Nullable dynamocity.Quarter
+type NullQuarter = Nullable[Quarter]
+
+// NullTimeOfDay is a dynamocity.Nullable dynamocity.TimeOfDay, which distinguishes an unset time of day from midnight
+type NullTimeOfDay = Nullable[TimeOfDay]
+
+// NullDuration is a dynamocity.Nullable dynamocity.Duration, which distinguishes an unset duration from zero
+type NullDuration = Nullable[Duration]
+
+// NullEpochSeconds is a dynamocity.Nullable dynamocity.EpochSeconds
+type NullEpochSeconds = Nullable[EpochSeconds]
+
+// NullEpochMillis is a dynamocity.Nullable dynamocity.EpochMillis
+type NullEpochMillis = Nullable[EpochMillis]
+
+// NullEpochNanos is a dynamocity.Nullable dynamocity.EpochNanos
+type NullEpochNanos = Nullable[EpochNanos]
+
+// NewNullable is a factory function for creating a valid dynamocity.Nullable
+func NewNullable[T nullable](v T) Nullable[T] {
+	return Nullable[T]{
+		V:     v,
+		Valid: true,
+	}
+}
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal a dynamocity.Nullable
+// into the AttributeValue of V, or a NULL AttributeValue if it is not valid
+func (n Nullable[T]) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	if !n.Valid {
+		return nullAttributeValue(), nil
+	}
+	return n.V.attributeValue(), nil
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal
+// a types.AttributeValue into a dynamocity.Nullable, which is only valid if the AttributeValue is not NULL
+func (n *Nullable[T]) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if isNull(av) {
+		*n = Nullable[T]{}
+		return nil
+	}
+	var v T
+	if err := attributevalue.Unmarshal(av, &v); err != nil {
+		return err
+	}
+	*n = NewNullable(v)
+	return nil
+}
+
+// IsZero reports whether this dynamocity.Nullable is not valid
+func (n Nullable[T]) IsZero() bool {
+	return !n.Valid
+}
+
+// acceptsLegacy implements the legacyTarget interface to apply a dynamocity.LegacyDecoder to V
+func (n Nullable[T]) acceptsLegacy() bool {
+	var v T
+	target, ok := any(v).(legacyTarget)
+	return ok && target.acceptsLegacy()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal a JSON value into a dynamocity.Nullable,
+// which is only valid if the JSON value is not null
+func (n *Nullable[T]) UnmarshalJSON(b []byte) error {
+	if isJSONNull(b) {
+		*n = Nullable[T]{}
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*n = NewNullable(v)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal V, or a JSON null if it is not valid
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.V)
+}
+
+// nullAttributeValue is a helper function to return a NULL AttributeValue
+func nullAttributeValue() types.AttributeValue {
+	return &types.AttributeValueMemberNULL{
+		Value: true,
+	}
+}
+
+// isNull is a helper function to determine if a types.AttributeValue is absent or NULL
+func isNull(av types.AttributeValue) bool {
+	if av == nil {
+		return true
+	}
+	_, ok := av.(*types.AttributeValueMemberNULL)
+	return ok
+}
+
+// isJSONNull is a helper function to determine if a JSON value is null
+func isJSONNull(b []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(b), []byte("null"))
+}
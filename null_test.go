@@ -0,0 +1,179 @@
+package dynamocity_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/edwardsmatt/dynamocity"
+)
+
+func Test_ZeroMarshalsValue(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    attributevalue.Marshaler
+		expected types.AttributeValue
+	}{
+		{name: "Given a zero dynamocity.MillisTime, then marshal the zero timestamp", value: dynamocity.MillisTime{}, expected: &types.AttributeValueMemberS{Value: "0001-01-01T00:00:00.000Z"}},
+		{name: "Given a zero dynamocity.NanoTime, then marshal the zero timestamp", value: dynamocity.NanoTime{}, expected: &types.AttributeValueMemberS{Value: "0001-01-01T00:00:00.000000000Z"}},
+		{name: "Given a zero dynamocity.Date, then marshal the zero date", value: dynamocity.Date{}, expected: &types.AttributeValueMemberS{Value: "0001-01-01"}},
+		{name: "Given a zero dynamocity.EpochSeconds, then marshal the zero epoch", value: dynamocity.EpochSeconds{}, expected: &types.AttributeValueMemberN{Value: "-62135596800"}},
+		{name: "Given a zero dynamocity.YearMonth, then marshal the zero month", value: dynamocity.YearMonth{}, expected: &types.AttributeValueMemberS{Value: "0001-01"}},
+		{name: "Given an empty dynamocity.CompositeKey, then marshal NULL", value: dynamocity.CompositeKey{}, expected: &types.AttributeValueMemberNULL{Value: true}},
+	}
+
+	for _, tc := range cases {
+		av, err := tc.value.MarshalDynamoDBAttributeValue()
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(av, tc.expected) {
+			t.Errorf("%s. Expected '%v', Got '%v'", tc.name, tc.expected, av)
+		}
+	}
+
+	epoch, err := dynamocity.EpochSeconds(time.Unix(0, 0)).MarshalDynamoDBAttributeValue()
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if n, ok := epoch.(*types.AttributeValueMemberN); !ok || n.Value != "0" {
+		t.Errorf("Expected the Unix epoch to marshal as the number 0. Got '%v'", epoch)
+	}
+}
+
+func Test_ZeroOmitEmpty(t *testing.T) {
+	type TestType struct {
+		PartitionKey string                    `dynamodbav:"pk"`
+		ZeroKey      dynamocity.MillisTime     `dynamodbav:"zeroKey,omitempty"`
+		SparseKey    dynamocity.NullMillisTime `dynamodbav:"sparseKey,omitempty"`
+	}
+
+	testCase := TestType{
+		PartitionKey: "TEST",
+	}
+
+	item, err := attributevalue.MarshalMap(testCase)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if zero := decodeAttributeValue(item["zeroKey"], t); zero != "0001-01-01T00:00:00.000Z" {
+		t.Errorf("Expected a zero dynamocity.MillisTime tagged omitempty to marshal the zero timestamp. Got '%s'", zero)
+	}
+	if _, ok := item["sparseKey"].(*types.AttributeValueMemberNULL); !ok {
+		t.Errorf("Expected an invalid dynamocity.NullMillisTime to marshal as NULL by default. Got %T", item["sparseKey"])
+	}
+
+	item, err = attributevalue.MarshalMapWithOptions(testCase, func(o *attributevalue.EncoderOptions) {
+		o.OmitNullAttributeValues = true
+	})
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if zero := decodeAttributeValue(item["zeroKey"], t); zero != "0001-01-01T00:00:00.000Z" {
+		t.Errorf("Expected a zero dynamocity.MillisTime tagged omitempty to marshal the zero timestamp. Got '%s'", zero)
+	}
+	if _, ok := item["sparseKey"]; ok {
+		t.Errorf("Expected an invalid dynamocity.NullMillisTime tagged omitempty to be omitted")
+	}
+}
+
+func Test_ZeroUnmarshalNull(t *testing.T) {
+	type TestType struct {
+		MillisTime dynamocity.MillisTime   `dynamodbav:"millisTime" json:"millisTime"`
+		Date       dynamocity.Date         `dynamodbav:"date" json:"date"`
+		Epoch      dynamocity.EpochSeconds `dynamodbav:"epoch" json:"epoch"`
+	}
+
+	item := map[string]types.AttributeValue{
+		"millisTime": &types.AttributeValueMemberNULL{Value: true},
+		"date":       &types.AttributeValueMemberNULL{Value: true},
+		"epoch":      &types.AttributeValueMemberNULL{Value: true},
+	}
+
+	var fromDynamo TestType
+	if err := attributevalue.UnmarshalMap(item, &fromDynamo); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if !fromDynamo.MillisTime.IsZero() || !fromDynamo.Date.IsZero() || !fromDynamo.Epoch.IsZero() {
+		t.Errorf("Expected NULL attribute values to unmarshal as zero values. Got '%v'", fromDynamo)
+	}
+
+	var fromJSON TestType
+	if err := json.Unmarshal([]byte(`{"millisTime":null,"date":null,"epoch":null}`), &fromJSON); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if !fromJSON.MillisTime.IsZero() || !fromJSON.Date.IsZero() || !fromJSON.Epoch.IsZero() {
+		t.Errorf("Expected JSON null values to unmarshal as zero values. Got '%v'", fromJSON)
+	}
+}
+
+func Test_NullableRoundTrip(t *testing.T) {
+	type TestType struct {
+		Unset dynamocity.NullMillisTime `dynamodbav:"unset" json:"unset"`
+		Zero  dynamocity.NullMillisTime `dynamodbav:"zero" json:"zero"`
+		Set   dynamocity.NullDate       `dynamodbav:"set" json:"set"`
+	}
+
+	testCase := TestType{
+		Zero: dynamocity.NewNullable(dynamocity.MillisTime{}),
+		Set:  dynamocity.NewNullable(dynamocity.Date(time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC))),
+	}
+
+	item, err := attributevalue.MarshalMap(testCase)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if _, ok := item["unset"].(*types.AttributeValueMemberNULL); !ok {
+		t.Errorf("Expected an invalid dynamocity.Nullable to marshal as NULL. Got %T", item["unset"])
+	}
+	if zero := decodeAttributeValue(item["zero"], t); zero != "0001-01-01T00:00:00.000Z" {
+		t.Errorf("Expected a valid zero dynamocity.Nullable to marshal the zero value. Got '%s'", zero)
+	}
+	if set := decodeAttributeValue(item["set"], t); set != "2020-04-01" {
+		t.Errorf("Unexpected marshalled dynamocity.NullDate. Got '%s'", set)
+	}
+
+	var fromDynamo TestType
+	if err := attributevalue.UnmarshalMap(item, &fromDynamo); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if fromDynamo.Unset.Valid || !fromDynamo.Zero.Valid || !fromDynamo.Set.Valid {
+		t.Errorf("Unexpected validity after attribute value round trip. Got '%v'", fromDynamo)
+	}
+	if !fromDynamo.Zero.V.IsZero() {
+		t.Errorf("Expected a valid zero value after attribute value round trip. Got '%s'", fromDynamo.Zero.V)
+	}
+
+	jsonBytes, err := json.Marshal(testCase)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	expected := `{"unset":null,"zero":"0001-01-01T00:00:00.000Z","set":"2020-04-01"}`
+	if string(jsonBytes) != expected {
+		t.Errorf("Unexpected marshalled JSON. Expected '%s', Got '%s'", expected, string(jsonBytes))
+	}
+
+	var fromJSON TestType
+	if err := json.Unmarshal(jsonBytes, &fromJSON); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if fromJSON.Unset.Valid || !fromJSON.Zero.Valid || !fromJSON.Set.Valid {
+		t.Errorf("Unexpected validity after JSON round trip. Got '%v'", fromJSON)
+	}
+	if fromJSON.Set.V.String() != "2020-04-01" {
+		t.Errorf("Unexpected dynamocity.NullDate after JSON round trip. Got '%s'", fromJSON.Set.V)
+	}
+}
@@ -0,0 +1,64 @@
+package dynamocity
+
+import "time"
+
+// offsetPrecision is implemented by dynamocity.Offset to marshal a Timestamp in the time.Location of its value
+type offsetPrecision interface {
+	offset()
+}
+
+// Offset is a Precision which supplies the layout and resolution of the wrapped Precision P; however, a dynamocity.Time
+// with an Offset precision is marshalled in the time.Location of its value rather than being normalised to UTC.
+//
+// Every other dynamocity.Time is normalised to UTC when marshalled, which guarantees that the lexicographic order of
+// marshalled Timestamps is the same as their chronological order; whereas `2020-04-01T14:00:00.000+10:00` and
+// `2020-04-01T04:00:00.000Z` represent the same instant, but do not sort correctly as strings. An Offset precision is
+// therefore only intended for compatibility with values which were previously marshalled with an offset. It is chosen
+// per field, rather than by a package-level setting, so that opting one field out cannot change the order of another.
+//
+// A dynamocity.Date represents a calendar date rather than an instant, and is never normalised.
+type Offset[P Precision] struct{}
+
+// Layout implements the Precision interface to supply the layout of P
+func (Offset[P]) Layout() string {
+	return layout[P]()
+}
+
+// Resolution implements the Precision interface to supply the resolution of P
+func (Offset[P]) Resolution() time.Duration {
+	var p P
+	return p.Resolution()
+}
+
+func (Offset[P]) offset() {}
+
+func (Offset[P]) unwrap() Precision {
+	var p P
+	return p
+}
+
+// isOffset is a helper function to determine if the Precision P is, or wraps, a dynamocity.Offset precision
+func isOffset[P Precision]() bool {
+	var p P
+	return hasPrecision[offsetPrecision](p)
+}
+
+// normalise is a helper function to convert a time.Time to UTC for marshalling, unless P is a dynamocity.Offset precision
+func normalise[P Precision](t time.Time) time.Time {
+	if isOffset[P]() {
+		return t
+	}
+	return t.UTC()
+}
+
+// OffsetNanoTime is a dynamocity.NanoTime which is marshalled with the offset of its time.Location
+type OffsetNanoTime = Time[Offset[NanoPrecision]]
+
+// OffsetMicrosTime is a dynamocity.MicrosTime which is marshalled with the offset of its time.Location
+type OffsetMicrosTime = Time[Offset[MicrosPrecision]]
+
+// OffsetMillisTime is a dynamocity.MillisTime which is marshalled with the offset of its time.Location
+type OffsetMillisTime = Time[Offset[MillisPrecision]]
+
+// OffsetSecondsTime is a dynamocity.SecondsTime which is marshalled with the offset of its time.Location
+type OffsetSecondsTime = Time[Offset[SecondsPrecision]]
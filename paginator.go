@@ -0,0 +1,112 @@
+package dynamocity
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// TimeWindowQuery describes a query of a single partition key within an Interval, where the sort key is a
+// dynamocity.Time with the fixed precision supplied by P
+type TimeWindowQuery[P Precision] struct {
+	TableName        string
+	IndexName        string
+	PartitionKeyName string
+	// PartitionKey is the value of the partition key, which is marshalled with attributevalue.Marshal
+	PartitionKey interface{}
+	SortKeyName  string
+	Interval     Interval[P]
+	// PageSize is the maximum number of items evaluated by each Query, which is only limited by DynamoDB when zero
+	PageSize int32
+	// Descending yields the items newest first
+	Descending bool
+}
+
+// TimeWindowIterator iterates over the items of a TimeWindowQuery, unmarshalled into T. Each page is queried as the
+// iterator is advanced with Next, following LastEvaluatedKey until the Interval is exhausted. For example:
+//
+//	events := dynamocity.QueryTimeWindow[Event](ctx, client, query)
+//	for events.Next() {
+//		process(events.Item())
+//	}
+//	if err := events.Err(); err != nil {
+//		return err
+//	}
+type TimeWindowIterator[T any] struct {
+	ctx       context.Context
+	paginator *dynamodb.QueryPaginator
+	page      []map[string]types.AttributeValue
+	item      T
+	err       error
+}
+
+// QueryTimeWindow returns a TimeWindowIterator over the items of the TimeWindowQuery, unmarshalled into T in
+// chronological order, or newest first when Descending. No query is made until Next is called, and an invalid
+// TimeWindowQuery is reported by Err
+func QueryTimeWindow[T any, P Precision](ctx context.Context, client dynamodb.QueryAPIClient, q TimeWindowQuery[P]) *TimeWindowIterator[T] {
+	it := &TimeWindowIterator[T]{ctx: ctx}
+	skCondition, err := q.Interval.KeyCondition(q.SortKeyName)
+	if err != nil {
+		it.err = err
+		return it
+	}
+	keyCondition := expression.Key(q.PartitionKeyName).Equal(expression.Value(q.PartitionKey)).And(skCondition)
+	input, err := newQueryInput(q.TableName, q.IndexName, keyCondition)
+	if err != nil {
+		it.err = err
+		return it
+	}
+	input.ScanIndexForward = aws.Bool(!q.Descending)
+	if q.PageSize > 0 {
+		input.Limit = aws.Int32(q.PageSize)
+	}
+	it.paginator = dynamodb.NewQueryPaginator(client, input)
+	return it
+}
+
+// Next advances the TimeWindowIterator to the next item, querying the next page if required. Next returns false once
+// every item has been returned, or an error has occurred, including an item which cannot be unmarshalled or the
+// cancellation of the context before a page is queried; after which Err reports the error
+func (it *TimeWindowIterator[T]) Next() bool {
+	var zero T
+	it.item = zero
+	if it.err != nil {
+		return false
+	}
+	for len(it.page) == 0 {
+		if !it.paginator.HasMorePages() {
+			return false
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+		page, err := it.paginator.NextPage(it.ctx)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page = page.Items
+	}
+	if err := attributevalue.UnmarshalMap(it.page[0], &it.item); err != nil {
+		it.item, it.err = zero, err
+		return false
+	}
+	it.page = it.page[1:]
+	return true
+}
+
+// Item returns the item which the TimeWindowIterator was advanced to by the last call to Next, or a zero T if Next
+// returned false
+func (it *TimeWindowIterator[T]) Item() T {
+	return it.item
+}
+
+// Err returns the error which stopped the TimeWindowIterator, or nil if it has not stopped or every item was returned
+func (it *TimeWindowIterator[T]) Err() error {
+	return it.err
+}
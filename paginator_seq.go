@@ -0,0 +1,29 @@
+//go:build go1.23
+
+package dynamocity
+
+import "iter"
+
+// All returns an iter.Seq2 over the remaining items of the TimeWindowIterator, for use with a range over func loop.
+// Breaking out of the loop stops querying, and an error is yielded once with a zero T, after which the sequence stops.
+// For example:
+//
+//	for event, err := range dynamocity.QueryTimeWindow[Event](ctx, client, query).All() {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+func (it *TimeWindowIterator[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for it.Next() {
+			if !yield(it.Item(), nil) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+//go:build go1.23
+
+package dynamocity_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edwardsmatt/dynamocity"
+)
+
+func Test_QueryTimeWindowAll(t *testing.T) {
+	type Event struct {
+		SK dynamocity.MillisTime `dynamodbav:"sk"`
+	}
+
+	query := dynamocity.TimeWindowQuery[dynamocity.MillisPrecision]{
+		TableName:        "events",
+		PartitionKeyName: "pk",
+		PartitionKey:     "DEVICE#1",
+		SortKeyName:      "sk",
+		Interval:         dynamocity.NewInterval(millisAt(14, 0), millisAt(15, 0)),
+		PageSize:         1,
+	}
+
+	client := newFakeQueryClient(100,
+		[2]string{"DEVICE#1", "2020-04-01T14:00:00.000Z"},
+		[2]string{"DEVICE#1", "2020-04-01T14:15:00.000Z"},
+		[2]string{"DEVICE#1", "2020-04-01T14:30:00.000Z"},
+	)
+	var actual []string
+	for event, err := range dynamocity.QueryTimeWindow[Event](context.Background(), client, query).All() {
+		if err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+		actual = append(actual, event.SK.Time().Format("15:04"))
+		if len(actual) == 2 {
+			break
+		}
+	}
+	if len(actual) != 2 || actual[0] != "14:00" || actual[1] != "14:15" || len(client.queried) != 2 {
+		t.Errorf("Expected breaking out of the loop to stop querying. Got '%v' from %d pages", actual, len(client.queried))
+	}
+
+	client.fail = "DEVICE#1"
+	errs := 0
+	for _, err := range dynamocity.QueryTimeWindow[Event](context.Background(), client, query).All() {
+		if err == nil || err.Error() != "throttled" {
+			t.Errorf("Expected the client error to be yielded. Got '%v'", err)
+		}
+		errs++
+	}
+	if errs != 1 {
+		t.Errorf("Expected the error to be yielded once. Got %d", errs)
+	}
+}
@@ -0,0 +1,143 @@
+package dynamocity_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/edwardsmatt/dynamocity"
+)
+
+func Test_QueryTimeWindow(t *testing.T) {
+	type Event struct {
+		PK string                `dynamodbav:"pk"`
+		SK dynamocity.MillisTime `dynamodbav:"sk"`
+	}
+
+	newClient := func() *fakeQueryClient {
+		return newFakeQueryClient(100,
+			[2]string{"DEVICE#1", "2020-04-01T13:59:59.999Z"},
+			[2]string{"DEVICE#1", "2020-04-01T14:00:00.000Z"},
+			[2]string{"DEVICE#1", "2020-04-01T14:15:00.000Z"},
+			[2]string{"DEVICE#1", "2020-04-01T14:30:00.000Z"},
+			[2]string{"DEVICE#1", "2020-04-01T14:45:00.000Z"},
+			[2]string{"DEVICE#1", "2020-04-01T14:50:00.000Z"},
+			[2]string{"DEVICE#1", "2020-04-01T15:00:00.000Z"},
+			[2]string{"DEVICE#2", "2020-04-01T14:20:00.000Z"},
+		)
+	}
+
+	query := dynamocity.TimeWindowQuery[dynamocity.MillisPrecision]{
+		TableName:        "events",
+		PartitionKeyName: "pk",
+		PartitionKey:     "DEVICE#1",
+		SortKeyName:      "sk",
+		Interval:         dynamocity.NewInterval(millisAt(14, 0), millisAt(15, 0)),
+		PageSize:         2,
+	}
+
+	cases := []struct {
+		name          string
+		descending    bool
+		limit         int
+		expected      []string
+		expectedPages int
+	}{
+		{name: "Given ascending order, then yield every page in chronological order", expected: []string{"14:00", "14:15", "14:30", "14:45", "14:50"}, expectedPages: 3},
+		{name: "Given descending order, then yield newest first", descending: true, expected: []string{"14:50", "14:45", "14:30", "14:15", "14:00"}, expectedPages: 3},
+		{name: "Given the iterator is not advanced, then do not query further pages", limit: 3, expected: []string{"14:00", "14:15", "14:30"}, expectedPages: 2},
+	}
+
+	for _, tc := range cases {
+		client := newClient()
+		q := query
+		q.Descending = tc.descending
+
+		var actual []string
+		events := dynamocity.QueryTimeWindow[Event](context.Background(), client, q)
+		for events.Next() {
+			actual = append(actual, events.Item().SK.Time().Format("15:04"))
+			if len(actual) == tc.limit {
+				break
+			}
+		}
+		if err := events.Err(); err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+		}
+
+		if len(actual) != len(tc.expected) {
+			t.Errorf("%s. Expected '%v', Got '%v'", tc.name, tc.expected, actual)
+			continue
+		}
+		for i := range actual {
+			if actual[i] != tc.expected[i] {
+				t.Errorf("%s. Expected '%v', Got '%v'", tc.name, tc.expected, actual)
+				break
+			}
+		}
+		if len(client.queried) != tc.expectedPages {
+			t.Errorf("%s. Expected %d pages to be queried, Got %d", tc.name, tc.expectedPages, len(client.queried))
+		}
+	}
+}
+
+func Test_QueryTimeWindowErrors(t *testing.T) {
+	type Event struct {
+		SK dynamocity.MillisTime `dynamodbav:"sk"`
+	}
+
+	query := dynamocity.TimeWindowQuery[dynamocity.MillisPrecision]{
+		TableName:        "events",
+		PartitionKeyName: "pk",
+		PartitionKey:     "DEVICE#1",
+		SortKeyName:      "sk",
+		Interval:         dynamocity.NewInterval(millisAt(14, 0), millisAt(15, 0)),
+	}
+
+	// collect is a helper function to consume the iterator, returning the number of items and the first error
+	collect := func(ctx context.Context, client *fakeQueryClient, q dynamocity.TimeWindowQuery[dynamocity.MillisPrecision]) (int, error) {
+		count := 0
+		events := dynamocity.QueryTimeWindow[Event](ctx, client, q)
+		for events.Next() {
+			count++
+		}
+		if events.Next() {
+			t.Errorf("Expected a stopped iterator to remain stopped")
+		}
+		return count, events.Err()
+	}
+
+	client := newFakeQueryClient(1, [2]string{"DEVICE#1", "2020-04-01T14:00:00.000Z"}, [2]string{"DEVICE#1", "2020-04-01T14:30:00.000Z"})
+	client.fail = "DEVICE#1"
+	if _, err := collect(context.Background(), client, query); err == nil || err.Error() != "throttled" {
+		t.Errorf("Expected the client error to be yielded. Got '%v'", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	client.fail = ""
+	if count, err := collect(ctx, client, query); !errors.Is(err, context.Canceled) || count != 0 {
+		t.Errorf("Expected a cancelled context to be yielded before any item. Got %d items and '%v'", count, err)
+	}
+
+	renamed := query
+	renamed.PartitionKeyName = "deviceId"
+	if _, err := collect(context.Background(), client, renamed); err == nil || err.Error() != "no partition key condition" {
+		t.Errorf("Expected an error when the partition key name does not match the table. Got '%v'", err)
+	}
+	client.partitionKeyName = "deviceId"
+	if count, err := collect(context.Background(), client, renamed); err != nil || count != 2 {
+		t.Errorf("Expected the condition to use the PartitionKeyName. Got %d items and '%v'", count, err)
+	}
+
+	empty := query
+	empty.Interval = dynamocity.NewInterval(millisAt(15, 0), millisAt(14, 0))
+	if _, err := collect(context.Background(), client, empty); err == nil {
+		t.Errorf("Expected an error for an empty Interval")
+	}
+
+	client = newFakeQueryClient(1, [2]string{"DEVICE#1", "2020-04-01T14:00:00.000Z+invalid"})
+	if _, err := collect(context.Background(), client, query); err == nil {
+		t.Errorf("Expected an unmarshalling error to be yielded")
+	}
+}
@@ -0,0 +1,201 @@
+package dynamocity
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// Precision supplies the fixed width layout of a dynamocity.Time, which makes the marshalled Timestamp string sortable.
+//
+// A Precision is implemented by an empty struct type, which allows declaring a custom precision. For example, a
+// Timestamp with fixed tenths of a second precision could be declared as:
+//
+//	type TenthsPrecision struct{}
+//
+//	func (TenthsPrecision) Layout() string            { return "2006-01-02T15:04:05.0Z07:00" }
+//	func (TenthsPrecision) Resolution() time.Duration { return 100 * time.Millisecond }
+//
+//	type TenthsTime = dynamocity.Time[TenthsPrecision]
+type Precision interface {
+	// Layout is the time.Time layout used when marshalling. The layout must always format to the same width.
+	Layout() string
+	// Resolution is the smallest duration which can be represented by the Layout
+	Resolution() time.Duration
+}
+
+// Time represents a sortable strict RFC3339 Timestamp with the fixed precision supplied by P, making it string sortable.
+// Time implements attributevalue.Marshaler, attributevalue.Unmarshaler
+// NanoTime, MicrosTime, MillisTime and SecondsTime are each a Time with a built in Precision.
+type Time[P Precision] time.Time
+
+// layout is a helper function to return the layout of the Precision P
+func layout[P Precision]() string {
+	var p P
+	return p.Layout()
+}
+
+// wrappedPrecision is implemented by a Precision which wraps another Precision, such as dynamocity.Strict or
+// dynamocity.Offset, so that wrapping precisions can be combined. For example, `Strict[Offset[MillisPrecision]]`
+type wrappedPrecision interface {
+	unwrap() Precision
+}
+
+// hasPrecision is a helper function to determine if the Precision p, or any Precision wrapped by p, implements W
+func hasPrecision[W any](p Precision) bool {
+	for {
+		if _, ok := p.(W); ok {
+			return true
+		}
+		wrapped, ok := p.(wrappedPrecision)
+		if !ok {
+			return false
+		}
+		p = wrapped.unwrap()
+	}
+}
+
+// parseTime is a helper function to parse a string to a time.Time according to the Precision P.
+//
+// This function will parse using dynamocity.FlexibleNanoFmt, unless P is a dynamocity.Strict precision in which case the
+// string must exactly match the layout of P, including normalisation to UTC unless P is a dynamocity.Offset precision
+func parseTime[P Precision](str string) (time.Time, error) {
+	var p P
+	if !isStrict[P]() {
+		return time.Parse(FlexibleNanoFmt, str)
+	}
+	parsedTime, err := time.Parse(p.Layout(), str)
+	if err != nil || normalise[P](parsedTime).Format(p.Layout()) != str {
+		return time.Time{}, fmt.Errorf("Timestamp '%s' does not strictly match the layout '%s'", str, p.Layout())
+	}
+	return parsedTime, nil
+}
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal
+// a dynamocity.Time into a DynamoDB AttributeValue string value with the fixed precision of P.
+func (t Time[P]) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return t.attributeValue(), nil
+}
+
+// attributeValue is a helper function to marshal a dynamocity.Time into a string AttributeValue
+func (t Time[P]) attributeValue() types.AttributeValue {
+	return &types.AttributeValueMemberS{
+		Value: t.String(),
+	}
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal
+// a types.AttributeValue into a dynamocity.Time. This unmarshal is flexible on fractional second precision, unless P
+// is a dynamocity.Strict precision. A NULL AttributeValue is unmarshalled as a zero dynamocity.Time
+func (t *Time[P]) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if isNull(av) {
+		*t = Time[P]{}
+		return nil
+	}
+	tv, ok := av.(*types.AttributeValueMemberS)
+	if !ok {
+		return &attributevalue.UnmarshalTypeError{
+			Value: fmt.Sprintf("%T", av),
+			Type:  reflect.TypeOf((*Time[P])(nil)),
+		}
+	}
+	rfc339Time, err := parseTime[P](tv.Value)
+	if err != nil {
+		return err
+	}
+	*t = Time[P](rfc339Time)
+	return nil
+}
+
+// Time is a handler func to return an instance of dynamocity.Time as time.Time
+func (t Time[P]) Time() time.Time {
+	return time.Time(t)
+}
+
+// acceptsLegacy implements the legacyTarget interface, as a dynamocity.LegacyDecoder is never applied to a
+// dynamocity.Strict precision
+func (t Time[P]) acceptsLegacy() bool {
+	return !isStrict[P]()
+}
+
+// String implements the fmt.Stringer interface to supply a native String representation for a value in RFC3339
+// Format with the fixed precision of P. The value is normalised to UTC unless P is a dynamocity.Offset precision
+func (t Time[P]) String() string {
+	return normalise[P](t.Time()).Format(layout[P]())
+}
+
+// utcString is a helper function to format a dynamocity.Time in UTC with the fixed precision of P, even if P is a
+// dynamocity.Offset precision, which is the canonical form compared by key conditions
+func (t Time[P]) utcString() string {
+	return t.Time().UTC().Format(layout[P]())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal RFC3339 timestamps, which is flexible on
+// fractional second precision, unless P is a dynamocity.Strict precision. A JSON null is a no-op
+func (t *Time[P]) UnmarshalJSON(b []byte) error {
+	if isJSONNull(b) {
+		return nil
+	}
+	str, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	parsedTime, err := parseTime[P](str)
+	if err != nil {
+		return fmt.Errorf("Timestamp '%s' cannot be unmarshalled as a valid RFC3339 timestamp: %w", str, err)
+	}
+	*t = Time[P](parsedTime)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal RFC3339 timestamps with the fixed precision of P
+func (t Time[P]) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(t.String())), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface to unmarshal RFC3339 timestamps, which is flexible on
+// fractional second precision, unless P is a dynamocity.Strict precision
+func (t *Time[P]) UnmarshalText(b []byte) error {
+	parsedTime, err := parseTime[P](string(b))
+	if err != nil {
+		return fmt.Errorf("Timestamp '%s' cannot be unmarshalled as a valid RFC3339 timestamp: %w", string(b), err)
+	}
+	*t = Time[P](parsedTime)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface to marshal RFC3339 timestamps with the fixed precision of P
+func (t Time[P]) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// IsZero reports whether this dynamocity.Time represents the zero time instant
+func (t Time[P]) IsZero() bool {
+	return t.Time().IsZero()
+}
+
+// Truncate returns the result of rounding this dynamocity.Time down to the Resolution of P, which is
+// equal to the value that would be unmarshalled from its marshalled string
+func (t Time[P]) Truncate() Time[P] {
+	var p P
+	return Time[P](t.Time().Truncate(p.Resolution()))
+}
+
+// EpochSeconds returns this dynamocity.Time as a dynamocity.EpochSeconds
+func (t Time[P]) EpochSeconds() EpochSeconds {
+	return EpochSeconds(t)
+}
+
+// EpochMillis returns this dynamocity.Time as a dynamocity.EpochMillis
+func (t Time[P]) EpochMillis() EpochMillis {
+	return EpochMillis(t)
+}
+
+// EpochNanos returns this dynamocity.Time as a dynamocity.EpochNanos
+func (t Time[P]) EpochNanos() EpochNanos {
+	return EpochNanos(t)
+}
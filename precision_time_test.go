@@ -0,0 +1,124 @@
+package dynamocity_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/edwardsmatt/dynamocity"
+)
+
+type TenthsPrecision struct{}
+
+func (TenthsPrecision) Layout() string            { return "2006-01-02T15:04:05.0Z07:00" }
+func (TenthsPrecision) Resolution() time.Duration { return 100 * time.Millisecond }
+
+type TenthsTime = dynamocity.Time[TenthsPrecision]
+
+func Test_CustomPrecisionRoundTrip(t *testing.T) {
+	type TestType struct {
+		TenthsTime TenthsTime `dynamodbav:"tenthsTime"`
+	}
+
+	testCase := TestType{
+		TenthsTime: TenthsTime(time.Date(2020, time.April, 1, 14, 0, 0, 987654321, time.UTC)),
+	}
+
+	item, err := attributevalue.MarshalMap(testCase)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	avString := decodeAttributeValue(item["tenthsTime"], t)
+	if avString != "2020-04-01T14:00:00.9Z" {
+		t.Errorf("Unexpected string attribute value. Expected '%s', Got '%s'", "2020-04-01T14:00:00.9Z", avString)
+	}
+
+	var unmarshalled TestType
+	if err := attributevalue.UnmarshalMap(item, &unmarshalled); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	expected := testCase.TenthsTime.Truncate()
+	if !unmarshalled.TenthsTime.Time().Equal(expected.Time()) {
+		t.Errorf("Unexpected unmarshalled time. Got '%v', want '%v'", unmarshalled.TenthsTime, expected)
+	}
+}
+
+func Test_PrecisionAliasesMarshalling(t *testing.T) {
+	timestamp := time.Date(2020, time.April, 1, 14, 0, 0, 120000000, time.UTC)
+
+	cases := []struct {
+		name     string
+		actual   interface{ String() string }
+		expected string
+	}{
+		{
+			name:     "Given a dynamocity.NanoTime, then marshal using StrictNanoFmt",
+			actual:   dynamocity.NanoTime(timestamp),
+			expected: "2020-04-01T14:00:00.120000000Z",
+		},
+		{
+			name:     "Given a dynamocity.Time with NanoPrecision, then marshal identically to a dynamocity.NanoTime",
+			actual:   dynamocity.Time[dynamocity.NanoPrecision](timestamp),
+			expected: "2020-04-01T14:00:00.120000000Z",
+		},
+		{
+			name:     "Given a dynamocity.MicrosTime, then marshal using StrictMicrosFmt",
+			actual:   dynamocity.MicrosTime(timestamp),
+			expected: "2020-04-01T14:00:00.120000Z",
+		},
+		{
+			name:     "Given a dynamocity.MillisTime, then marshal using StrictMillisFmt",
+			actual:   dynamocity.MillisTime(timestamp),
+			expected: "2020-04-01T14:00:00.120Z",
+		},
+		{
+			name:     "Given a dynamocity.SecondsTime, then marshal using StrictSecondsFmt",
+			actual:   dynamocity.SecondsTime(timestamp),
+			expected: "2020-04-01T14:00:00Z",
+		},
+	}
+
+	for _, tc := range cases {
+		if tc.actual.String() != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, tc.actual.String())
+		}
+	}
+}
+
+func Test_UnmarshalTypeError(t *testing.T) {
+	cases := []struct {
+		name         string
+		target       interface{}
+		expectedType reflect.Type
+	}{
+		{
+			name:         "Given a number attribute value, when unmarshalling a dynamocity.MillisTime, then report a dynamocity.MillisTime type error",
+			target:       new(dynamocity.MillisTime),
+			expectedType: reflect.TypeOf((*dynamocity.MillisTime)(nil)),
+		},
+		{
+			name:         "Given a number attribute value, when unmarshalling a dynamocity.Date, then report a dynamocity.Date type error",
+			target:       new(dynamocity.Date),
+			expectedType: reflect.TypeOf((*dynamocity.Date)(nil)),
+		},
+	}
+
+	for _, tc := range cases {
+		err := attributevalue.Unmarshal(&types.AttributeValueMemberN{Value: "1"}, tc.target)
+		var typeErr *attributevalue.UnmarshalTypeError
+		if !errors.As(err, &typeErr) {
+			t.Errorf("%s. Expected an UnmarshalTypeError, Got '%v'", tc.name, err)
+			continue
+		}
+		if typeErr.Type != tc.expectedType {
+			t.Errorf("%s. Expected type '%s', Got '%s'", tc.name, tc.expectedType, typeErr.Type)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Package protoconv converts between the dynamocity types and the protocol buffer well known Timestamp and Duration
+// types. It is a separate package so that the protobuf dependency is only required where these conversions are used.
+package protoconv
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/edwardsmatt/dynamocity"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TimeFromProto converts a timestamppb.Timestamp to a dynamocity.Time, truncating it to the Resolution of P so that
+// it is equal to the value which is unmarshalled. A nil timestamppb.Timestamp is converted to a zero dynamocity.Time,
+// and an invalid timestamppb.Timestamp, such as one with nanos outside [0, 1e9), returns an error
+func TimeFromProto[P dynamocity.Precision](ts *timestamppb.Timestamp) (dynamocity.Time[P], error) {
+	if ts == nil {
+		return dynamocity.Time[P]{}, nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return dynamocity.Time[P]{}, err
+	}
+	return dynamocity.Time[P](ts.AsTime()).Truncate(), nil
+}
+
+// NanoTimeFromProto converts a timestamppb.Timestamp to a dynamocity.NanoTime, which is lossless
+func NanoTimeFromProto(ts *timestamppb.Timestamp) (dynamocity.NanoTime, error) {
+	return TimeFromProto[dynamocity.NanoPrecision](ts)
+}
+
+// MicrosTimeFromProto converts a timestamppb.Timestamp to a dynamocity.MicrosTime, truncated to the microsecond
+func MicrosTimeFromProto(ts *timestamppb.Timestamp) (dynamocity.MicrosTime, error) {
+	return TimeFromProto[dynamocity.MicrosPrecision](ts)
+}
+
+// MillisTimeFromProto converts a timestamppb.Timestamp to a dynamocity.MillisTime, truncated to the millisecond
+func MillisTimeFromProto(ts *timestamppb.Timestamp) (dynamocity.MillisTime, error) {
+	return TimeFromProto[dynamocity.MillisPrecision](ts)
+}
+
+// SecondsTimeFromProto converts a timestamppb.Timestamp to a dynamocity.SecondsTime, truncated to the second
+func SecondsTimeFromProto(ts *timestamppb.Timestamp) (dynamocity.SecondsTime, error) {
+	return TimeFromProto[dynamocity.SecondsPrecision](ts)
+}
+
+// DateFromProto converts a timestamppb.Timestamp to the dynamocity.Date of its UTC instant. A nil
+// timestamppb.Timestamp is converted to a zero dynamocity.Date
+func DateFromProto(ts *timestamppb.Timestamp) (dynamocity.Date, error) {
+	if ts == nil {
+		return dynamocity.Date{}, nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return dynamocity.Date{}, err
+	}
+	year, month, day := ts.AsTime().Date()
+	return dynamocity.NewDate(year, month, day), nil
+}
+
+// DurationFromProto converts a durationpb.Duration to a dynamocity.Duration. A nil durationpb.Duration is converted
+// to a zero dynamocity.Duration, and a durationpb.Duration which is invalid or exceeds the range of a time.Duration
+// (approximately 292 years) returns an error rather than saturating
+func DurationFromProto(d *durationpb.Duration) (dynamocity.Duration, error) {
+	if d == nil {
+		return 0, nil
+	}
+	if err := d.CheckValid(); err != nil {
+		return 0, err
+	}
+	seconds := time.Duration(d.GetSeconds()) * time.Second
+	nanos := seconds + time.Duration(d.GetNanos())
+	if int64(seconds/time.Second) != d.GetSeconds() || (d.GetNanos() > 0 && nanos < seconds) || (d.GetNanos() < 0 && nanos > seconds) {
+		return 0, fmt.Errorf("Duration '%s' exceeds the range of a time.Duration", d)
+	}
+	return dynamocity.Duration(nanos), nil
+}
+
+// TimestampProto returns a dynamocity.Time as a timestamppb.Timestamp, truncated to the Resolution of P.
+// A zero dynamocity.Time is returned as nil, which is an unset message field
+func TimestampProto[P dynamocity.Precision](t dynamocity.Time[P]) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t.Truncate().Time())
+}
+
+// DateTimestampProto returns midnight UTC of a dynamocity.Date as a timestamppb.Timestamp, which is the instant of the
+// dynamocity.Date once it has been marshalled and unmarshalled. A zero dynamocity.Date is returned as nil, which is an
+// unset message field
+func DateTimestampProto(d dynamocity.Date) *timestamppb.Timestamp {
+	if d.IsZero() {
+		return nil
+	}
+	year, month, day := d.Time().Date()
+	return timestamppb.New(dynamocity.NewDate(year, month, day).Time())
+}
+
+// DurationProto returns a dynamocity.Duration as a durationpb.Duration, which is lossless
+func DurationProto(d dynamocity.Duration) *durationpb.Duration {
+	return durationpb.New(d.Duration())
+}
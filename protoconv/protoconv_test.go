@@ -0,0 +1,141 @@
+package protoconv_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/edwardsmatt/dynamocity"
+	"github.com/edwardsmatt/dynamocity/protoconv"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_TimeFromProtoTruncation(t *testing.T) {
+	ts := &timestamppb.Timestamp{Seconds: 1585749599, Nanos: 999999999}
+	beforeEpoch := &timestamppb.Timestamp{Seconds: -1, Nanos: 999999999}
+
+	convert := func(ts *timestamppb.Timestamp, f func(*timestamppb.Timestamp) (string, error)) string {
+		str, err := f(ts)
+		if err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+		return str
+	}
+	nano := func(ts *timestamppb.Timestamp) (string, error) {
+		v, err := protoconv.NanoTimeFromProto(ts)
+		return v.String(), err
+	}
+	micros := func(ts *timestamppb.Timestamp) (string, error) {
+		v, err := protoconv.MicrosTimeFromProto(ts)
+		return v.String(), err
+	}
+	millis := func(ts *timestamppb.Timestamp) (string, error) {
+		v, err := protoconv.MillisTimeFromProto(ts)
+		return v.String(), err
+	}
+	seconds := func(ts *timestamppb.Timestamp) (string, error) {
+		v, err := protoconv.SecondsTimeFromProto(ts)
+		return v.String(), err
+	}
+	date := func(ts *timestamppb.Timestamp) (string, error) {
+		v, err := protoconv.DateFromProto(ts)
+		return v.String(), err
+	}
+
+	cases := []struct {
+		name     string
+		actual   string
+		expected string
+	}{
+		{name: "Given NanoTimeFromProto, then keep nanoseconds", actual: convert(ts, nano), expected: "2020-04-01T13:59:59.999999999Z"},
+		{name: "Given MicrosTimeFromProto, then truncate to microseconds", actual: convert(ts, micros), expected: "2020-04-01T13:59:59.999999Z"},
+		{name: "Given MillisTimeFromProto, then truncate to milliseconds", actual: convert(ts, millis), expected: "2020-04-01T13:59:59.999Z"},
+		{name: "Given SecondsTimeFromProto, then truncate to seconds", actual: convert(ts, seconds), expected: "2020-04-01T13:59:59Z"},
+		{name: "Given DateFromProto, then the UTC date", actual: convert(ts, date), expected: "2020-04-01"},
+		{name: "Given a Timestamp before the epoch, then truncate towards the past", actual: convert(beforeEpoch, seconds), expected: "1969-12-31T23:59:59Z"},
+		{name: "Given a nil Timestamp, then a zero value", actual: convert(nil, millis), expected: "0001-01-01T00:00:00.000Z"},
+	}
+
+	for _, tc := range cases {
+		if tc.actual != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, tc.actual)
+		}
+	}
+
+	if millisTime, _ := protoconv.MillisTimeFromProto(ts); millisTime.Time().Nanosecond() != 999000000 {
+		t.Errorf("Expected the underlying time to be truncated, so that it equals the unmarshalled value. Got '%d'", millisTime.Time().Nanosecond())
+	}
+	if _, err := protoconv.MillisTimeFromProto(&timestamppb.Timestamp{Seconds: 1585749599, Nanos: -1}); err == nil {
+		t.Errorf("Expected an error converting a Timestamp with negative nanos")
+	}
+}
+
+func Test_TimestampProto(t *testing.T) {
+	instant := time.Date(2020, time.April, 1, 23, 59, 59, 999999999, time.FixedZone("AEST", 10*60*60))
+
+	cases := []struct {
+		name            string
+		actual          *timestamppb.Timestamp
+		expectedSeconds int64
+		expectedNanos   int32
+	}{
+		{name: "Given a NanoTime, then keep nanoseconds", actual: protoconv.TimestampProto(dynamocity.NanoTime(instant)), expectedSeconds: 1585749599, expectedNanos: 999999999},
+		{name: "Given a MillisTime, then truncate to milliseconds", actual: protoconv.TimestampProto(dynamocity.MillisTime(instant)), expectedSeconds: 1585749599, expectedNanos: 999000000},
+		{name: "Given a SecondsTime, then truncate to seconds", actual: protoconv.TimestampProto(dynamocity.SecondsTime(instant)), expectedSeconds: 1585749599, expectedNanos: 0},
+		{name: "Given a Date, then midnight", actual: protoconv.DateTimestampProto(dynamocity.NewDate(2020, time.April, 1)), expectedSeconds: 1585699200, expectedNanos: 0},
+		{name: "Given a Date with a time and location, then midnight UTC of the date", actual: protoconv.DateTimestampProto(dynamocity.Date(instant)), expectedSeconds: 1585699200, expectedNanos: 0},
+	}
+
+	for _, tc := range cases {
+		if tc.actual.GetSeconds() != tc.expectedSeconds || tc.actual.GetNanos() != tc.expectedNanos {
+			t.Errorf("%s. Expected '%d.%09d', Got '%d.%09d'", tc.name, tc.expectedSeconds, tc.expectedNanos, tc.actual.GetSeconds(), tc.actual.GetNanos())
+		}
+	}
+
+	if ts := protoconv.TimestampProto(dynamocity.MillisTime{}); ts != nil {
+		t.Errorf("Expected a zero MillisTime to convert to nil. Got '%v'", ts)
+	}
+	if ts := protoconv.DateTimestampProto(dynamocity.Date{}); ts != nil {
+		t.Errorf("Expected a zero Date to convert to nil. Got '%v'", ts)
+	}
+}
+
+func Test_DurationProto(t *testing.T) {
+	cases := []struct {
+		name        string
+		input       *durationpb.Duration
+		expected    time.Duration
+		expectedErr bool
+	}{
+		{name: "Given a positive Duration, then convert losslessly", input: &durationpb.Duration{Seconds: 5400, Nanos: 1}, expected: 90*time.Minute + time.Nanosecond},
+		{name: "Given a negative Duration, then convert losslessly", input: &durationpb.Duration{Seconds: -1, Nanos: -500000000}, expected: -1500 * time.Millisecond},
+		{name: "Given the maximum time.Duration, then convert losslessly", input: durationpb.New(math.MaxInt64), expected: math.MaxInt64},
+		{name: "Given the minimum time.Duration, then convert losslessly", input: durationpb.New(math.MinInt64), expected: math.MinInt64},
+		{name: "Given a nil Duration, then zero", input: nil, expected: 0},
+		{name: "Given a Duration beyond the range of a time.Duration, then error", input: &durationpb.Duration{Seconds: 9223372036, Nanos: 854775808}, expectedErr: true},
+		{name: "Given a Duration of 300 years, then error", input: &durationpb.Duration{Seconds: 300 * 365 * 24 * 60 * 60}, expectedErr: true},
+		{name: "Given mismatched signs, then error", input: &durationpb.Duration{Seconds: 1, Nanos: -1}, expectedErr: true},
+	}
+
+	for _, tc := range cases {
+		actual, err := protoconv.DurationFromProto(tc.input)
+		if tc.expectedErr {
+			if err == nil {
+				t.Errorf("%s. Expected an error, Got '%s'", tc.name, actual)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if actual.Duration() != tc.expected {
+			t.Errorf("%s. Expected '%v', Got '%v'", tc.name, tc.expected, actual.Duration())
+		}
+		if roundTrip := protoconv.DurationProto(actual).AsDuration(); tc.input != nil && roundTrip != tc.input.AsDuration() {
+			t.Errorf("%s. Expected '%v' after round trip, Got '%v'", tc.name, tc.input.AsDuration(), roundTrip)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package dynamocity
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// ReverseTime represents a Timestamp with the fixed precision supplied by P, which is marshalled such that the
+// lexicographic order of the string is the reverse of the chronological order, making newer Timestamps sort first.
+//
+// ReverseTime is marshalled by formatting the Timestamp in UTC with the layout of P, and then replacing each digit d
+// with 9-d. For example, `2020-04-01T14:00:00.000Z` as a ReverseMillisTime is marshalled as `7979-95-98T85:99:99.999Z`.
+// This is useful for newest first ordering within a composite sort key, where ScanIndexForward=false is not applicable.
+type ReverseTime[P Precision] time.Time
+
+// ReverseNanoTime is a dynamocity.ReverseTime with fixed nanosecond precision
+type ReverseNanoTime = ReverseTime[NanoPrecision]
+
+// ReverseMicrosTime is a dynamocity.ReverseTime with fixed microsecond precision
+type ReverseMicrosTime = ReverseTime[MicrosPrecision]
+
+// ReverseMillisTime is a dynamocity.ReverseTime with fixed millisecond precision
+type ReverseMillisTime = ReverseTime[MillisPrecision]
+
+// ReverseSecondsTime is a dynamocity.ReverseTime with fixed second precision
+type ReverseSecondsTime = ReverseTime[SecondsPrecision]
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal
+// a dynamocity.ReverseTime into a DynamoDB AttributeValue string value.
+func (t ReverseTime[P]) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return t.attributeValue(), nil
+}
+
+// attributeValue is a helper function to marshal a dynamocity.ReverseTime into a string AttributeValue
+func (t ReverseTime[P]) attributeValue() types.AttributeValue {
+	return &types.AttributeValueMemberS{
+		Value: t.String(),
+	}
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal
+// a types.AttributeValue into a dynamocity.ReverseTime. A NULL AttributeValue is unmarshalled as a zero dynamocity.ReverseTime
+func (t *ReverseTime[P]) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if isNull(av) {
+		*t = ReverseTime[P]{}
+		return nil
+	}
+	tv, ok := av.(*types.AttributeValueMemberS)
+	if !ok {
+		return &attributevalue.UnmarshalTypeError{
+			Value: fmt.Sprintf("%T", av),
+			Type:  reflect.TypeOf((*ReverseTime[P])(nil)),
+		}
+	}
+	parsedTime, err := parseReverse[P](tv.Value)
+	if err != nil {
+		return err
+	}
+	*t = ReverseTime[P](parsedTime)
+	return nil
+}
+
+// parseReverse is a helper function to parse a reversed Timestamp string with the layout of P
+func parseReverse[P Precision](str string) (time.Time, error) {
+	parsedTime, err := time.Parse(layout[P](), reverseDigits(str))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Reverse Timestamp '%s' cannot be unmarshalled with the layout '%s'", str, layout[P]())
+	}
+	return parsedTime, nil
+}
+
+// reverseDigits is a helper function which replaces each digit d in a string with 9-d
+func reverseDigits(str string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return '9' - r + '0'
+		}
+		return r
+	}, str)
+}
+
+// IsZero reports whether this dynamocity.ReverseTime represents the zero time instant
+func (t ReverseTime[P]) IsZero() bool {
+	return t.Time().IsZero()
+}
+
+// Time is a handler func to return an instance of dynamocity.ReverseTime as time.Time
+func (t ReverseTime[P]) Time() time.Time {
+	return time.Time(t)
+}
+
+// String implements the fmt.Stringer interface to supply the reversed representation of the Timestamp. A ReverseTime
+// is always formatted in UTC, even with a dynamocity.Offset precision, as an offset would prevent the reversed string
+// from sorting correctly
+func (t ReverseTime[P]) String() string {
+	return reverseDigits(t.Time().UTC().Format(layout[P]()))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal a reversed Timestamp. A JSON null is a no-op
+func (t *ReverseTime[P]) UnmarshalJSON(b []byte) error {
+	if isJSONNull(b) {
+		return nil
+	}
+	str, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	parsedTime, err := parseReverse[P](str)
+	if err != nil {
+		return err
+	}
+	*t = ReverseTime[P](parsedTime)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal a reversed Timestamp
+func (t ReverseTime[P]) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(t.String())), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface to unmarshal a reversed Timestamp
+func (t *ReverseTime[P]) UnmarshalText(b []byte) error {
+	parsedTime, err := parseReverse[P](string(b))
+	if err != nil {
+		return err
+	}
+	*t = ReverseTime[P](parsedTime)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface to marshal a reversed Timestamp
+func (t ReverseTime[P]) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// Forward returns this dynamocity.ReverseTime as a dynamocity.Time of the same precision
+func (t ReverseTime[P]) Forward() Time[P] {
+	return Time[P](t)
+}
+
+// Reverse returns this dynamocity.Time as a dynamocity.ReverseTime of the same precision
+func (t Time[P]) Reverse() ReverseTime[P] {
+	return ReverseTime[P](t)
+}
@@ -0,0 +1,99 @@
+package dynamocity_test
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/edwardsmatt/dynamocity"
+)
+
+func Test_ReverseTimeMarshalling(t *testing.T) {
+	brisbane := time.FixedZone("AEST", 10*60*60)
+
+	cases := []struct {
+		name     string
+		actual   interface{ String() string }
+		expected string
+	}{
+		{
+			name:     "Given a dynamocity.ReverseMillisTime, then marshal the complement of each digit",
+			actual:   dynamocity.ReverseMillisTime(time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC)),
+			expected: "7979-95-98T85:99:99.999Z",
+		},
+		{
+			name:     "Given a dynamocity.ReverseSecondsTime with an offset, then marshal the complement of the UTC Timestamp",
+			actual:   dynamocity.ReverseSecondsTime(time.Date(2020, time.April, 1, 14, 0, 0, 0, brisbane)),
+			expected: "7979-95-98T95:99:99Z",
+		},
+		{
+			name:     "Given a dynamocity.ReverseNanoTime, then marshal with fixed nanosecond precision",
+			actual:   dynamocity.ReverseNanoTime(time.Date(2020, time.April, 1, 14, 0, 0, 123456789, time.UTC)),
+			expected: "7979-95-98T85:99:99.876543210Z",
+		},
+	}
+
+	for _, tc := range cases {
+		if tc.actual.String() != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, tc.actual.String())
+		}
+	}
+}
+
+func Test_ReverseTimeSortsNewestFirst(t *testing.T) {
+	base := time.Date(2019, time.December, 9, 6, 50, 2, 0, time.UTC)
+	offsets := []time.Duration{0, time.Millisecond, time.Second, time.Hour, 24 * time.Hour, 400 * 24 * time.Hour}
+
+	keys := make([]string, len(offsets))
+	for i, offset := range offsets {
+		keys[i] = "EVENT#" + dynamocity.ReverseMillisTime(base.Add(offset)).String()
+	}
+
+	if !sort.SliceIsSorted(keys, func(i, j int) bool { return keys[i] > keys[j] }) {
+		t.Errorf("Expected reversed keys of ascending Timestamps to sort in descending order. Got '%v'", keys)
+	}
+}
+
+func Test_ReverseTimeRoundTrip(t *testing.T) {
+	type TestType struct {
+		Reverse dynamocity.ReverseMicrosTime `dynamodbav:"reverse" json:"reverse"`
+	}
+
+	timestamp := time.Date(2020, time.April, 1, 14, 0, 0, 123456000, time.UTC)
+	testCase := TestType{Reverse: dynamocity.MicrosTime(timestamp).Reverse()}
+
+	item, err := attributevalue.MarshalMap(testCase)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	var fromDynamo TestType
+	if err := attributevalue.UnmarshalMap(item, &fromDynamo); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if !fromDynamo.Reverse.Forward().Time().Equal(timestamp) {
+		t.Errorf("Unexpected attribute value round trip. Got '%v', want '%v'", fromDynamo.Reverse.Time(), timestamp)
+	}
+
+	jsonBytes, err := json.Marshal(testCase)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	var fromJSON TestType
+	if err := json.Unmarshal(jsonBytes, &fromJSON); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if !fromJSON.Reverse.Time().Equal(timestamp) {
+		t.Errorf("Unexpected JSON round trip. Got '%v', want '%v'", fromJSON.Reverse.Time(), timestamp)
+	}
+
+	var invalid dynamocity.ReverseMicrosTime
+	if err := json.Unmarshal([]byte(`"2020-04-01T14:00:00.123Z"`), &invalid); err == nil {
+		t.Errorf("Expected an error when unmarshalling a value which is not a reversed microsecond Timestamp")
+	}
+}
@@ -0,0 +1,236 @@
+package dynamocity
+
+import (
+	"cmp"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// sortableIDTimeLength is the fixed length of the UTC dynamocity.NanoTime within a marshalled dynamocity.SortableID
+const sortableIDTimeLength = len("2006-01-02T15:04:05.000000000Z")
+
+// sortableIDLength is the fixed length of a marshalled dynamocity.SortableID
+const sortableIDLength = sortableIDTimeLength + len("_ffff_ffffffffffffffff")
+
+// SortableID represents a unique identifier which is string sortable in creation order, for use as a sort key where
+// many items may share the same Timestamp.
+//
+// SortableID is marshalled as a UTC dynamocity.NanoTime, followed by a 4 digit hexadecimal counter and a 16 digit
+// hexadecimal random suffix, each separated by an underscore. For example: `2020-04-01T14:00:00.000000000Z_0000_9f86d081884c7d65`.
+// The counter orders IDs which are generated by the same IDGenerator within the same nanosecond, and the random suffix
+// avoids collisions between generators.
+type SortableID struct {
+	time    time.Time
+	counter uint16
+	random  uint64
+}
+
+// IDGenerator generates a monotonically increasing sequence of dynamocity.SortableID values, which is safe for
+// concurrent use. The zero value is ready to use, and uses the current system time.
+type IDGenerator struct {
+	// Clock supplies the Timestamp of each dynamocity.SortableID. The current system time is used if Clock is nil
+	Clock Clock
+
+	mu      sync.Mutex
+	last    time.Time
+	counter uint16
+}
+
+// defaultIDGenerator is the IDGenerator used by NewSortableID
+var defaultIDGenerator IDGenerator
+
+// NewSortableID generates a dynamocity.SortableID for the current time, which is ordered after every dynamocity.SortableID
+// previously generated by this process
+func NewSortableID() (SortableID, error) {
+	return defaultIDGenerator.Next()
+}
+
+// Next generates a dynamocity.SortableID for the current time, which is ordered after every dynamocity.SortableID
+// previously generated by this IDGenerator, even if the clock does not advance or moves backwards
+func (g *IDGenerator) Next() (SortableID, error) {
+	var randomBytes [8]byte
+	if _, err := rand.Read(randomBytes[:]); err != nil {
+		return SortableID{}, fmt.Errorf("Unable to generate a random SortableID suffix: %w", err)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	// Round(0) strips any monotonic clock reading, so that IDs are ordered by the wall clock which is embedded in them
+	now := clockOrSystem(g.Clock).Now().UTC().Round(0)
+	switch {
+	case now.After(g.last):
+		g.last, g.counter = now, 0
+	case g.counter == math.MaxUint16:
+		g.last, g.counter = g.last.Add(time.Nanosecond), 0
+	default:
+		g.counter++
+	}
+
+	return SortableID{
+		time:    g.last,
+		counter: g.counter,
+		random:  binary.BigEndian.Uint64(randomBytes[:]),
+	}, nil
+}
+
+// MinSortableID returns the lowest possible dynamocity.SortableID for the dynamocity.NanoTime, which is the inclusive
+// lower bound of a sort key range query starting at t
+func MinSortableID(t NanoTime) SortableID {
+	return SortableID{time: t.Time().UTC()}
+}
+
+// MaxSortableID returns the highest possible dynamocity.SortableID for the dynamocity.NanoTime, which is the inclusive
+// upper bound of a sort key range query ending at t
+func MaxSortableID(t NanoTime) SortableID {
+	return SortableID{time: t.Time().UTC(), counter: math.MaxUint16, random: math.MaxUint64}
+}
+
+// Time is a handler func to return the embedded Timestamp of a dynamocity.SortableID as time.Time
+func (id SortableID) Time() time.Time {
+	return id.time
+}
+
+// NanoTime returns the embedded Timestamp of a dynamocity.SortableID as a dynamocity.NanoTime
+func (id SortableID) NanoTime() NanoTime {
+	return NanoTime(id.time)
+}
+
+// MillisTime returns the embedded Timestamp of a dynamocity.SortableID as a dynamocity.MillisTime
+func (id SortableID) MillisTime() MillisTime {
+	return MillisTime(id.time)
+}
+
+// IsZero reports whether this dynamocity.SortableID is the zero value
+func (id SortableID) IsZero() bool {
+	return id == SortableID{}
+}
+
+// Compare compares this dynamocity.SortableID with u in the order of their marshalled strings
+func (id SortableID) Compare(u SortableID) int {
+	if c := id.time.Compare(u.time); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(id.counter, u.counter); c != 0 {
+		return c
+	}
+	return cmp.Compare(id.random, u.random)
+}
+
+// String implements the fmt.Stringer interface to supply the marshalled dynamocity.SortableID
+func (id SortableID) String() string {
+	return fmt.Sprintf("%s_%04x_%016x", id.time.UTC().Format(StrictNanoFmt), id.counter, id.random)
+}
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal
+// a dynamocity.SortableID into a DynamoDB AttributeValue string value.
+func (id SortableID) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return id.attributeValue(), nil
+}
+
+// attributeValue is a helper function to marshal a dynamocity.SortableID into a string AttributeValue
+func (id SortableID) attributeValue() types.AttributeValue {
+	return &types.AttributeValueMemberS{
+		Value: id.String(),
+	}
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal
+// a types.AttributeValue into a dynamocity.SortableID. A NULL AttributeValue is unmarshalled as a zero dynamocity.SortableID
+func (id *SortableID) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if isNull(av) {
+		*id = SortableID{}
+		return nil
+	}
+	tv, ok := av.(*types.AttributeValueMemberS)
+	if !ok {
+		return &attributevalue.UnmarshalTypeError{
+			Value: fmt.Sprintf("%T", av),
+			Type:  reflect.TypeOf((*SortableID)(nil)),
+		}
+	}
+	parsed, err := ParseSortableID(tv.Value)
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal a dynamocity.SortableID. A JSON null is a no-op
+func (id *SortableID) UnmarshalJSON(b []byte) error {
+	if isJSONNull(b) {
+		return nil
+	}
+	str, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	parsed, err := ParseSortableID(str)
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal a dynamocity.SortableID
+func (id SortableID) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(id.String())), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface to unmarshal a dynamocity.SortableID
+func (id *SortableID) UnmarshalText(b []byte) error {
+	parsed, err := ParseSortableID(string(b))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface to marshal a dynamocity.SortableID
+func (id SortableID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+// ParseSortableID will attempt to parse a marshalled dynamocity.SortableID
+func ParseSortableID(str string) (SortableID, error) {
+	invalid := fmt.Errorf("SortableID '%s' cannot be unmarshalled", str)
+	timestampLength := sortableIDTimeLength
+	if len(str) != sortableIDLength || str[timestampLength] != '_' || str[timestampLength+5] != '_' {
+		return SortableID{}, invalid
+	}
+	parsedTime, err := time.Parse(StrictNanoFmt, str[:timestampLength])
+	if err != nil || parsedTime.UTC().Format(StrictNanoFmt) != str[:timestampLength] {
+		return SortableID{}, invalid
+	}
+	counter, err := parseHex(str[timestampLength+1:timestampLength+5], 16)
+	if err != nil {
+		return SortableID{}, invalid
+	}
+	random, err := parseHex(str[timestampLength+6:], 64)
+	if err != nil {
+		return SortableID{}, invalid
+	}
+	return SortableID{time: parsedTime.UTC(), counter: uint16(counter), random: random}, nil
+}
+
+// parseHex is a helper function to parse a fixed width lower case hexadecimal string
+func parseHex(str string, bitSize int) (uint64, error) {
+	for _, r := range str {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return 0, fmt.Errorf("'%s' is not lower case hexadecimal", str)
+		}
+	}
+	return strconv.ParseUint(str, 16, bitSize)
+}
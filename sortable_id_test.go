@@ -0,0 +1,169 @@
+package dynamocity_test
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/edwardsmatt/dynamocity"
+)
+
+func Test_SortableIDMonotonic(t *testing.T) {
+	var generator dynamocity.IDGenerator
+
+	const workers, perWorker = 8, 500
+	ids := make([][]dynamocity.SortableID, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				id, err := generator.Next()
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				ids[w] = append(ids[w], id)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	seen := map[string]bool{}
+	var all []string
+	for _, workerIDs := range ids {
+		for i, id := range workerIDs {
+			if i > 0 && workerIDs[i-1].String() >= id.String() {
+				t.Errorf("Expected IDs from a single goroutine to be strictly increasing. Got '%s' then '%s'", workerIDs[i-1], id)
+			}
+			if seen[id.String()] {
+				t.Errorf("Unexpected duplicate ID '%s'", id)
+			}
+			seen[id.String()] = true
+			all = append(all, id.String())
+		}
+	}
+	if len(all) != workers*perWorker {
+		t.Errorf("Unexpected number of IDs. Expected '%d', Got '%d'", workers*perWorker, len(all))
+	}
+}
+
+func Test_SortableIDRoundTrip(t *testing.T) {
+	type TestType struct {
+		ID dynamocity.SortableID `dynamodbav:"id" json:"id"`
+	}
+
+	id, err := dynamocity.NewSortableID()
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	testCase := TestType{ID: id}
+
+	item, err := attributevalue.MarshalMap(testCase)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if actual := decodeAttributeValue(item["id"], t); actual != id.String() || len(actual) != 52 {
+		t.Errorf("Unexpected marshalled dynamocity.SortableID. Got '%s'", actual)
+	}
+	var fromDynamo TestType
+	if err := attributevalue.UnmarshalMap(item, &fromDynamo); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if fromDynamo != testCase {
+		t.Errorf("Unexpected dynamocity.SortableID after attribute value round trip. Expected '%s', Got '%s'", testCase.ID, fromDynamo.ID)
+	}
+
+	jsonBytes, err := json.Marshal(testCase)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	var fromJSON TestType
+	if err := json.Unmarshal(jsonBytes, &fromJSON); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if fromJSON != testCase {
+		t.Errorf("Unexpected dynamocity.SortableID after JSON round trip. Expected '%s', Got '%s'", testCase.ID, fromJSON.ID)
+	}
+
+	av, err := attributevalue.Marshal(dynamocity.SortableID{})
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if zero := decodeAttributeValue(av, t); zero != "0001-01-01T00:00:00.000000000Z_0000_0000000000000000" {
+		t.Errorf("Expected a zero dynamocity.SortableID to marshal the zero ID. Got '%s'", zero)
+	}
+}
+
+func Test_SortableIDParsing(t *testing.T) {
+	cases := []struct {
+		name        string
+		input       string
+		expectedErr bool
+	}{
+		{name: "Given a valid ID, then parse", input: "2020-04-01T14:00:00.000000000Z_0001_9f86d081884c7d65"},
+		{name: "Given an offset Timestamp, then error", input: "2020-04-01T14:00:00.000000000+10:00_0001_9f86d0818", expectedErr: true},
+		{name: "Given upper case hexadecimal, then error", input: "2020-04-01T14:00:00.000000000Z_0001_9F86D081884C7D65", expectedErr: true},
+		{name: "Given a missing separator, then error", input: "2020-04-01T14:00:00.000000000Z00001_9f86d081884c7d65", expectedErr: true},
+		{name: "Given a truncated ID, then error", input: "2020-04-01T14:00:00.000000000Z_0001", expectedErr: true},
+	}
+
+	for _, tc := range cases {
+		id, err := dynamocity.ParseSortableID(tc.input)
+		if tc.expectedErr {
+			if err == nil {
+				t.Errorf("%s. Expected an error, Got '%s'", tc.name, id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if id.String() != tc.input {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.input, id)
+		}
+		if expected := "2020-04-01T14:00:00.000Z"; id.MillisTime().String() != expected {
+			t.Errorf("%s. Expected embedded Timestamp '%s', Got '%s'", tc.name, expected, id.MillisTime())
+		}
+	}
+}
+
+func Test_SortableIDBounds(t *testing.T) {
+	start := dynamocity.NanoTime(time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC))
+	end := dynamocity.NanoTime(time.Date(2020, time.April, 1, 15, 0, 0, 0, time.UTC))
+
+	ids := []string{
+		"2020-04-01T13:59:59.999999999Z_ffff_ffffffffffffffff",
+		"2020-04-01T14:00:00.000000000Z_0000_0000000000000001",
+		"2020-04-01T14:30:00.000000000Z_0001_9f86d081884c7d65",
+		"2020-04-01T15:00:00.000000000Z_ffff_fffffffffffffffe",
+		"2020-04-01T15:00:00.000000001Z_0000_0000000000000000",
+	}
+	sort.Strings(ids)
+
+	lower, upper := dynamocity.MinSortableID(start).String(), dynamocity.MaxSortableID(end).String()
+	var within []string
+	for _, id := range ids {
+		if id >= lower && id <= upper {
+			within = append(within, id)
+		}
+	}
+	if len(within) != 3 || within[0] != ids[1] || within[2] != ids[3] {
+		t.Errorf("Unexpected IDs between '%s' and '%s'. Got '%v'", lower, upper, within)
+	}
+
+	if !dynamocity.BetweenInclusiveOf(dynamocity.MinSortableID(start), dynamocity.MinSortableID(start), dynamocity.MaxSortableID(end)) {
+		t.Errorf("Expected the lower bound ID to be between inclusive")
+	}
+}
@@ -0,0 +1,71 @@
+package dynamocity
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// Scan implements the sql.Scanner interface to scan a time.Time, string or []byte value from a database driver into
+// a dynamocity.Time, truncated to the Resolution of P. Strings are parsed with the same rules as UnmarshalText
+func (t *Time[P]) Scan(src interface{}) error {
+	scanned, err := scanTime(src, parseTime[P])
+	if err != nil {
+		return err
+	}
+	*t = Time[P](scanned).Truncate()
+	return nil
+}
+
+// Value implements the driver.Valuer interface to supply a dynamocity.Time to a database driver as a time.Time,
+// truncated to the Resolution of P. The value is normalised to UTC unless P is a dynamocity.Offset precision.
+// A zero dynamocity.Time is supplied as a SQL NULL, so that it round trips with Scan
+func (t Time[P]) Value() (driver.Value, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return normalise[P](t.Truncate().Time()), nil
+}
+
+// Scan implements the sql.Scanner interface to scan a time.Time, string or []byte value from a database driver into
+// a dynamocity.Date, truncated to midnight of the date
+func (t *Date) Scan(src interface{}) error {
+	scanned, err := scanTime(src, parse)
+	if err != nil {
+		return err
+	}
+	*t = Date(midnight(scanned))
+	return nil
+}
+
+// Value implements the driver.Valuer interface to supply a dynamocity.Date to a database driver as a time.Time
+// at midnight of the date. A zero dynamocity.Date is supplied as a SQL NULL, so that it round trips with Scan
+func (t Date) Value() (driver.Value, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return midnight(t.Time()), nil
+}
+
+// midnight is a helper function to truncate a time.Time to midnight of the same date, in the same time.Location
+func midnight(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// scanTime is a helper function to convert a value supplied by a database driver to a time.Time, using the supplied
+// parse function for string and []byte values
+func scanTime(src interface{}, parse func(string) (time.Time, error)) (time.Time, error) {
+	switch v := src.(type) {
+	case nil:
+		return time.Time{}, nil
+	case time.Time:
+		return v, nil
+	case string:
+		return parse(v)
+	case []byte:
+		return parse(string(v))
+	default:
+		return time.Time{}, fmt.Errorf("Value of type %T cannot be scanned as a timestamp", src)
+	}
+}
@@ -0,0 +1,203 @@
+package dynamocity_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/edwardsmatt/dynamocity"
+)
+
+// echoDriver is an in-process database/sql driver which returns a single row containing the query arguments
+type echoDriver struct{}
+
+func (echoDriver) Open(name string) (driver.Conn, error) { return echoConn{}, nil }
+
+type echoConn struct{}
+
+func (echoConn) Prepare(query string) (driver.Stmt, error) { return echoStmt{}, nil }
+func (echoConn) Close() error                              { return nil }
+func (echoConn) Begin() (driver.Tx, error)                 { return nil, errors.New("transactions are not supported") }
+
+type echoStmt struct{}
+
+func (echoStmt) Close() error  { return nil }
+func (echoStmt) NumInput() int { return -1 }
+func (echoStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("exec is not supported")
+}
+func (echoStmt) Query(args []driver.Value) (driver.Rows, error) { return &echoRows{values: args}, nil }
+
+type echoRows struct {
+	values []driver.Value
+	done   bool
+}
+
+func (r *echoRows) Columns() []string { return make([]string, len(r.values)) }
+func (r *echoRows) Close() error      { return nil }
+func (r *echoRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.values)
+	return nil
+}
+
+func init() {
+	sql.Register("dynamocity-echo", echoDriver{})
+}
+
+func Test_SQLRoundTrip(t *testing.T) {
+	db, err := sql.Open("dynamocity-echo", "")
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	defer db.Close()
+
+	brisbane := time.FixedZone("AEST", 10*60*60)
+	timestamp := time.Date(2020, time.April, 1, 14, 0, 0, 123456789, brisbane)
+
+	cases := []struct {
+		name     string
+		arg      interface{}
+		dest     interface{ String() string }
+		expected string
+	}{
+		{
+			name:     "Given a dynamocity.NanoTime, then scan with nanosecond precision",
+			arg:      dynamocity.NanoTime(timestamp),
+			dest:     new(dynamocity.NanoTime),
+			expected: "2020-04-01T04:00:00.123456789Z",
+		},
+		{
+			name:     "Given a dynamocity.MillisTime, then scan truncated to millisecond precision",
+			arg:      dynamocity.MillisTime(timestamp),
+			dest:     new(dynamocity.MillisTime),
+			expected: "2020-04-01T04:00:00.123Z",
+		},
+		{
+			name:     "Given a dynamocity.SecondsTime, then scan truncated to second precision",
+			arg:      dynamocity.SecondsTime(timestamp),
+			dest:     new(dynamocity.SecondsTime),
+			expected: "2020-04-01T04:00:00Z",
+		},
+		{
+			name:     "Given a dynamocity.Date, then scan the date",
+			arg:      dynamocity.Date(timestamp),
+			dest:     new(dynamocity.Date),
+			expected: "2020-04-01",
+		},
+		{
+			name:     "Given a time.Time, when scanning a dynamocity.MillisTime, then truncate to millisecond precision",
+			arg:      timestamp,
+			dest:     new(dynamocity.MillisTime),
+			expected: "2020-04-01T04:00:00.123Z",
+		},
+		{
+			name:     "Given a string, when scanning a dynamocity.MillisTime, then parse and truncate to millisecond precision",
+			arg:      "2020-04-01T04:00:00.98765Z",
+			dest:     new(dynamocity.MillisTime),
+			expected: "2020-04-01T04:00:00.987Z",
+		},
+		{
+			name:     "Given a byte slice, when scanning a dynamocity.SecondsTime, then parse and truncate to second precision",
+			arg:      []byte("2020-04-01T04:00:00.98765Z"),
+			dest:     new(dynamocity.SecondsTime),
+			expected: "2020-04-01T04:00:00Z",
+		},
+		{
+			name:     "Given a string, when scanning a dynamocity.Date, then parse the date",
+			arg:      "2020-04-01",
+			dest:     new(dynamocity.Date),
+			expected: "2020-04-01",
+		},
+	}
+
+	for _, tc := range cases {
+		if err := db.QueryRow("SELECT ?", tc.arg).Scan(tc.dest); err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if tc.dest.String() != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, tc.dest.String())
+		}
+	}
+}
+
+func Test_SQLValue(t *testing.T) {
+	timestamp := time.Date(2020, time.April, 1, 14, 0, 0, 123456789, time.UTC)
+
+	value, err := dynamocity.MillisTime(timestamp).Value()
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if !value.(time.Time).Equal(timestamp.Truncate(time.Millisecond)) {
+		t.Errorf("Unexpected driver value. Expected '%v', Got '%v'", timestamp.Truncate(time.Millisecond), value)
+	}
+
+	value, err = dynamocity.Date(timestamp).Value()
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	expectedDate := time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC)
+	if !value.(time.Time).Equal(expectedDate) {
+		t.Errorf("Unexpected driver value. Expected '%v', Got '%v'", expectedDate, value)
+	}
+}
+
+func Test_SQLValueZero(t *testing.T) {
+	cases := []struct {
+		name  string
+		value driver.Valuer
+	}{
+		{name: "Given a zero dynamocity.MillisTime, then supply a SQL NULL", value: dynamocity.MillisTime{}},
+		{name: "Given a zero dynamocity.StrictNanoTime, then supply a SQL NULL", value: dynamocity.StrictNanoTime{}},
+		{name: "Given a zero dynamocity.OffsetSecondsTime, then supply a SQL NULL", value: dynamocity.OffsetSecondsTime{}},
+		{name: "Given a zero dynamocity.Date, then supply a SQL NULL", value: dynamocity.Date{}},
+	}
+
+	for _, tc := range cases {
+		value, err := tc.value.Value()
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if value != nil {
+			t.Errorf("%s. Expected nil, Got '%v'", tc.name, value)
+		}
+	}
+
+	db, err := sql.Open("dynamocity-echo", "")
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	defer db.Close()
+
+	scanned := dynamocity.MillisTime(time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC))
+	if err := db.QueryRow("SELECT ?", dynamocity.MillisTime{}).Scan(&scanned); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if !scanned.IsZero() {
+		t.Errorf("Expected a zero dynamocity.MillisTime to round trip as a zero value. Got '%s'", scanned)
+	}
+}
+
+func Test_SQLScanUnsupportedType(t *testing.T) {
+	var millisTime dynamocity.MillisTime
+	if err := millisTime.Scan(int64(1585749600)); err == nil {
+		t.Errorf("Expected an error when scanning an int64 into a dynamocity.MillisTime")
+	}
+	var strict dynamocity.StrictMillisTime
+	if err := strict.Scan("2020-04-01T04:00:00.1Z"); err == nil {
+		t.Errorf("Expected an error when scanning a non-canonical string into a dynamocity.StrictMillisTime")
+	}
+}
@@ -0,0 +1,51 @@
+package dynamocity
+
+import "time"
+
+// strictPrecision is implemented by dynamocity.Strict to require that unmarshalled Timestamps exactly match the layout
+type strictPrecision interface {
+	strict()
+}
+
+// Strict is a Precision which supplies the layout and resolution of the wrapped Precision P; however, unmarshalling a
+// dynamocity.Time with a Strict precision will result in an error unless the string exactly matches the layout of P, as it
+// would be marshalled. This ensures that non-canonical Timestamps, which may not sort correctly, are surfaced when read.
+//
+// For example, a StrictMillisTime will fail to unmarshal `2019-12-09T06:50:02.5Z`, whereas a MillisTime will succeed.
+type Strict[P Precision] struct{}
+
+// Layout implements the Precision interface to supply the layout of P
+func (Strict[P]) Layout() string {
+	return layout[P]()
+}
+
+// Resolution implements the Precision interface to supply the resolution of P
+func (Strict[P]) Resolution() time.Duration {
+	var p P
+	return p.Resolution()
+}
+
+func (Strict[P]) strict() {}
+
+func (Strict[P]) unwrap() Precision {
+	var p P
+	return p
+}
+
+// isStrict is a helper function to determine if the Precision P is, or wraps, a dynamocity.Strict precision
+func isStrict[P Precision]() bool {
+	var p P
+	return hasPrecision[strictPrecision](p)
+}
+
+// StrictNanoTime is a dynamocity.NanoTime which only unmarshals a Timestamp matching StrictNanoFmt
+type StrictNanoTime = Time[Strict[NanoPrecision]]
+
+// StrictMicrosTime is a dynamocity.MicrosTime which only unmarshals a Timestamp matching StrictMicrosFmt
+type StrictMicrosTime = Time[Strict[MicrosPrecision]]
+
+// StrictMillisTime is a dynamocity.MillisTime which only unmarshals a Timestamp matching StrictMillisFmt
+type StrictMillisTime = Time[Strict[MillisPrecision]]
+
+// StrictSecondsTime is a dynamocity.SecondsTime which only unmarshals a Timestamp matching StrictSecondsFmt
+type StrictSecondsTime = Time[Strict[SecondsPrecision]]
@@ -0,0 +1,111 @@
+package dynamocity_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/edwardsmatt/dynamocity"
+)
+
+func Test_StrictUnmarshalling(t *testing.T) {
+	cases := []struct {
+		name      string
+		timestamp string
+		target    interface{}
+		expectErr bool
+	}{
+		{
+			name:      "Given a canonical millisecond Timestamp, when unmarshalling a dynamocity.StrictMillisTime, then succeed",
+			timestamp: "2019-12-09T06:50:02.500Z",
+			target:    new(dynamocity.StrictMillisTime),
+			expectErr: false,
+		},
+		{
+			name:      "Given a Timestamp with trailing zeros removed, when unmarshalling a dynamocity.StrictMillisTime, then fail",
+			timestamp: "2019-12-09T06:50:02.5Z",
+			target:    new(dynamocity.StrictMillisTime),
+			expectErr: true,
+		},
+		{
+			name:      "Given a Timestamp with trailing zeros removed, when unmarshalling a dynamocity.MillisTime, then succeed",
+			timestamp: "2019-12-09T06:50:02.5Z",
+			target:    new(dynamocity.MillisTime),
+			expectErr: false,
+		},
+		{
+			name:      "Given a millisecond Timestamp with an offset, when unmarshalling a dynamocity.StrictMillisTime, then fail",
+			timestamp: "2019-12-09T16:50:02.500+10:00",
+			target:    new(dynamocity.StrictMillisTime),
+			expectErr: true,
+		},
+		{
+			name:      "Given a Timestamp with fractional seconds, when unmarshalling a dynamocity.StrictSecondsTime, then fail",
+			timestamp: "2019-12-09T06:50:02.5Z",
+			target:    new(dynamocity.StrictSecondsTime),
+			expectErr: true,
+		},
+		{
+			name:      "Given a canonical second Timestamp, when unmarshalling a dynamocity.StrictSecondsTime, then succeed",
+			timestamp: "2019-12-09T06:50:02Z",
+			target:    new(dynamocity.StrictSecondsTime),
+			expectErr: false,
+		},
+		{
+			name:      "Given a millisecond Timestamp, when unmarshalling a dynamocity.StrictNanoTime, then fail",
+			timestamp: "2019-12-09T06:50:02.533Z",
+			target:    new(dynamocity.StrictNanoTime),
+			expectErr: true,
+		},
+		{
+			name:      "Given a canonical microsecond Timestamp, when unmarshalling a dynamocity.StrictMicrosTime, then succeed",
+			timestamp: "2019-12-09T06:50:02.533237Z",
+			target:    new(dynamocity.StrictMicrosTime),
+			expectErr: false,
+		},
+		{
+			name:      "Given a canonical millisecond Timestamp with an offset, when unmarshalling a strict offset dynamocity.Time, then succeed",
+			timestamp: "2019-12-09T16:50:02.500+10:00",
+			target:    new(dynamocity.Time[dynamocity.Strict[dynamocity.Offset[dynamocity.MillisPrecision]]]),
+			expectErr: false,
+		},
+		{
+			name:      "Given a Timestamp with trailing zeros removed, when unmarshalling an offset strict dynamocity.Time, then fail",
+			timestamp: "2019-12-09T16:50:02.5+10:00",
+			target:    new(dynamocity.Time[dynamocity.Offset[dynamocity.Strict[dynamocity.MillisPrecision]]]),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		avErr := attributevalue.Unmarshal(&types.AttributeValueMemberS{Value: tc.timestamp}, tc.target)
+		if (avErr != nil) != tc.expectErr {
+			t.Errorf("%s. Unexpected attribute value unmarshal result, Got error '%v'", tc.name, avErr)
+		}
+		jsonErr := json.Unmarshal([]byte(strconv.Quote(tc.timestamp)), tc.target)
+		if (jsonErr != nil) != tc.expectErr {
+			t.Errorf("%s. Unexpected JSON unmarshal result, Got error '%v'", tc.name, jsonErr)
+		}
+	}
+}
+
+func Test_StrictMarshalling(t *testing.T) {
+	timestamp := time.Date(2019, time.December, 9, 6, 50, 2, 500000000, time.UTC)
+	strict := dynamocity.StrictMillisTime(timestamp)
+
+	if strict.String() != dynamocity.MillisTime(timestamp).String() {
+		t.Errorf("Expected a dynamocity.StrictMillisTime to marshal identically to a dynamocity.MillisTime. Got '%s'", strict)
+	}
+
+	var unmarshalled dynamocity.StrictMillisTime
+	if err := json.Unmarshal([]byte(strconv.Quote(strict.String())), &unmarshalled); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if !dynamocity.MillisTime(unmarshalled).Time().Equal(timestamp) {
+		t.Errorf("Unexpected unmarshalled time. Got '%v', want '%v'", unmarshalled, timestamp)
+	}
+}
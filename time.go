@@ -10,6 +10,14 @@ import "time"
 // nanosecond precision. For example: `2006-01-02T15:04:05.000000000Z07:00`
 const StrictNanoFmt = "2006-01-02T15:04:05.000000000Z07:00"
 
+// StrictMicrosFmt applies a strict microsecond precision marshalling of a dynamocity.MicrosTime.
+// This ensures that trailing zeros are never stripped. The standard library time.RFC3339Nano format
+// removes trailing zeros from the seconds field, and thus may not sort correctly once formatted.
+//
+// Unmarshalling using StrictMicrosFmt will result in errors if the string does not strictly match the RFC3339 format with fixed
+// microsecond precision. For example: `2006-01-02T15:04:05.000000Z07:00`
+const StrictMicrosFmt = "2006-01-02T15:04:05.000000Z07:00"
+
 // StrictMillisFmt applies a strict millisecond precision marshalling of a dynamocity.NanoTime.
 // This ensures that trailing zeros are never stripped. The standard library time.RFC3339Nano format
 // removes trailing zeros from the seconds field, and thus may not sort correctly once formatted.
@@ -37,30 +45,48 @@ const StrictDateFmt = "2006-01-02"
 // Therefore, this format is unsafe for marshalling to dynamo or JSON if the resultant value is expected to be sortable by string.
 const FlexibleNanoFmt = time.RFC3339Nano
 
+// Comparable is implemented by time.Time and each of the dynamocity types, where Compare returns -1, 0 or +1 if this
+// value is before, equal to or after u. Each dynamocity type compares in the same order as its marshalled value.
+type Comparable[T any] interface {
+	Compare(u T) int
+}
+
 // BetweenStartInc will return true if this time.Time is after or equal to the start and before the end
 func BetweenStartInc(t, startInclusive, endExclusive time.Time) bool {
-	afterOrEqualToStart := t.After(startInclusive) || t.Equal(startInclusive)
-	beforeEnd := t.Before(endExclusive)
-	return afterOrEqualToStart && beforeEnd
+	return BetweenStartIncOf(t, startInclusive, endExclusive)
 }
 
 // BetweenEndInc will return true if this time.Time is after the start and before or equal to the end
 func BetweenEndInc(t, startExclusive, endInclusive time.Time) bool {
-	afterStart := t.After(startExclusive)
-	beforeOrEqualToEnd := t.Before(endInclusive) || t.Equal(endInclusive)
-	return afterStart && beforeOrEqualToEnd
+	return BetweenEndIncOf(t, startExclusive, endInclusive)
 }
 
 // BetweenExclusive will return true if this time.Time is after the start and before to the end
 func BetweenExclusive(t, startExclusive, endExclusive time.Time) bool {
-	afterStart := t.After(startExclusive)
-	beforeEnd := t.Before(endExclusive)
-	return afterStart && beforeEnd
+	return BetweenExclusiveOf(t, startExclusive, endExclusive)
 }
 
 // BetweenInclusive will return true if this time.Time is after or equal to the start and before or equal to the end
 func BetweenInclusive(t, start, end time.Time) bool {
-	afterOrEqualToStart := t.After(start) || t.Equal(start)
-	beforeOrEqualToEnd := t.Before(end) || t.Equal(end)
-	return afterOrEqualToStart && beforeOrEqualToEnd
+	return BetweenInclusiveOf(t, start, end)
+}
+
+// BetweenStartIncOf will return true if t is after or equal to the start and before the end
+func BetweenStartIncOf[T Comparable[T]](t, startInclusive, endExclusive T) bool {
+	return t.Compare(startInclusive) >= 0 && t.Compare(endExclusive) < 0
+}
+
+// BetweenEndIncOf will return true if t is after the start and before or equal to the end
+func BetweenEndIncOf[T Comparable[T]](t, startExclusive, endInclusive T) bool {
+	return t.Compare(startExclusive) > 0 && t.Compare(endInclusive) <= 0
+}
+
+// BetweenExclusiveOf will return true if t is after the start and before to the end
+func BetweenExclusiveOf[T Comparable[T]](t, startExclusive, endExclusive T) bool {
+	return t.Compare(startExclusive) > 0 && t.Compare(endExclusive) < 0
+}
+
+// BetweenInclusiveOf will return true if t is after or equal to the start and before or equal to the end
+func BetweenInclusiveOf[T Comparable[T]](t, start, end T) bool {
+	return t.Compare(start) >= 0 && t.Compare(end) <= 0
 }
@@ -0,0 +1,175 @@
+package dynamocity
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// StrictTimeOfDayFmt is the layout of a dynamocity.TimeOfDay with fixed millisecond precision. For example: `15:04:05.000`
+const StrictTimeOfDayFmt = "15:04:05.000"
+
+// flexibleTimeOfDayFmt is the layout used to parse a dynamocity.TimeOfDay with any fractional second precision
+const flexibleTimeOfDayFmt = "15:04:05.999999999"
+
+// TimeOfDay represents a sortable wall clock time with the fixed format "HH:MM:SS.sss", independent of any date or
+// time.Location. A TimeOfDay is the time.Duration since midnight, from 00:00:00.000 up to 23:59:59.999.
+//
+// A zero TimeOfDay is midnight, which is a valid time of day, so it is always marshalled as "00:00:00.000"; use a
+// dynamocity.NullTimeOfDay where an unset time of day must be distinguished. TimeOfDay can unmarshal from "HH:MM:SS"
+// with any fractional second precision, or from the wall clock of any RFC3339 Timestamp.
+type TimeOfDay time.Duration
+
+// NewTimeOfDay is a factory function for creating a dynamocity.TimeOfDay. Values outside of a single day wrap
+// around midnight
+func NewTimeOfDay(hour, min, sec, nsec int) TimeOfDay {
+	d := time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute + time.Duration(sec)*time.Second + time.Duration(nsec)
+	return wrapTimeOfDay(d)
+}
+
+// TimeOfDayOf returns the wall clock dynamocity.TimeOfDay of a time.Time in its own time.Location
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	hour, min, sec := t.Clock()
+	return NewTimeOfDay(hour, min, sec, t.Nanosecond())
+}
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal
+// a dynamocity.TimeOfDay into a DynamoDB AttributeValue string value with fixed millisecond precision
+func (t TimeOfDay) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return t.attributeValue(), nil
+}
+
+// attributeValue is a helper function to marshal a dynamocity.TimeOfDay into a string AttributeValue
+func (t TimeOfDay) attributeValue() types.AttributeValue {
+	return &types.AttributeValueMemberS{
+		Value: t.String(),
+	}
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal
+// a types.AttributeValue into a dynamocity.TimeOfDay. A NULL AttributeValue is unmarshalled as midnight
+func (t *TimeOfDay) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if isNull(av) {
+		*t = 0
+		return nil
+	}
+	tv, ok := av.(*types.AttributeValueMemberS)
+	if !ok {
+		return &attributevalue.UnmarshalTypeError{
+			Value: fmt.Sprintf("%T", av),
+			Type:  reflect.TypeOf((*TimeOfDay)(nil)),
+		}
+	}
+	parsed, err := ParseTimeOfDay(tv.Value)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// Duration is a handler func to return the time.Duration since midnight of a dynamocity.TimeOfDay
+func (t TimeOfDay) Duration() time.Duration {
+	return time.Duration(t)
+}
+
+// IsZero reports whether this dynamocity.TimeOfDay is midnight
+func (t TimeOfDay) IsZero() bool {
+	return t == 0
+}
+
+// Hour returns the hour of this dynamocity.TimeOfDay, in the range [0, 23]
+func (t TimeOfDay) Hour() int {
+	return int(wrapTimeOfDay(t.Duration()).Duration() / time.Hour)
+}
+
+// Minute returns the minute offset within the hour of this dynamocity.TimeOfDay, in the range [0, 59]
+func (t TimeOfDay) Minute() int {
+	return int(wrapTimeOfDay(t.Duration()).Duration() % time.Hour / time.Minute)
+}
+
+// Second returns the second offset within the minute of this dynamocity.TimeOfDay, in the range [0, 59]
+func (t TimeOfDay) Second() int {
+	return int(wrapTimeOfDay(t.Duration()).Duration() % time.Minute / time.Second)
+}
+
+// Nanosecond returns the nanosecond offset within the second of this dynamocity.TimeOfDay, in the range [0, 999999999]
+func (t TimeOfDay) Nanosecond() int {
+	return int(wrapTimeOfDay(t.Duration()).Duration() % time.Second)
+}
+
+// String implements the fmt.Stringer interface to supply the time of day in the format HH:MM:SS.sss
+func (t TimeOfDay) String() string {
+	return t.On(Date{}, time.UTC).Time().Format(StrictTimeOfDayFmt)
+}
+
+// On returns the dynamocity.NanoTime of this dynamocity.TimeOfDay on the supplied dynamocity.Date, in the supplied
+// time.Location. A wall clock time which is skipped or repeated by a daylight saving transition is resolved as per
+// time.Date. The result can be converted to another precision, for example dynamocity.MillisTime(t.On(date, loc))
+func (t TimeOfDay) On(date Date, loc *time.Location) NanoTime {
+	year, month, day := date.Time().Date()
+	return NanoTime(time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal a time of day or RFC3339 timestamp. A JSON null is a no-op
+func (t *TimeOfDay) UnmarshalJSON(b []byte) error {
+	if isJSONNull(b) {
+		return nil
+	}
+	str, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	parsed, err := ParseTimeOfDay(str)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal a time of day in the format HH:MM:SS.sss
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(t.String())), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface to unmarshal a time of day or RFC3339 timestamp
+func (t *TimeOfDay) UnmarshalText(b []byte) error {
+	parsed, err := ParseTimeOfDay(string(b))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface to marshal a time of day in the format HH:MM:SS.sss
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// ParseTimeOfDay will attempt to parse a time of day with format HH:MM:SS and any fractional second precision, or the
+// wall clock of any RFC3339 Timestamp, to a dynamocity.TimeOfDay
+func ParseTimeOfDay(str string) (TimeOfDay, error) {
+	parsedTime, err := time.Parse(flexibleTimeOfDayFmt, str)
+	if err != nil {
+		parsedTime, err = time.Parse(FlexibleNanoFmt, str)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("Time of day '%s' cannot be unmarshalled", str)
+	}
+	return TimeOfDayOf(parsedTime), nil
+}
+
+// wrapTimeOfDay is a helper function to wrap a time.Duration around midnight into a dynamocity.TimeOfDay
+func wrapTimeOfDay(d time.Duration) TimeOfDay {
+	d %= 24 * time.Hour
+	if d < 0 {
+		d += 24 * time.Hour
+	}
+	return TimeOfDay(d)
+}
@@ -0,0 +1,165 @@
+package dynamocity_test
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/edwardsmatt/dynamocity"
+)
+
+func Test_TimeOfDayMarshalling(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    dynamocity.TimeOfDay
+		expected string
+	}{
+		{name: "Given a time of day, then marshal HH:MM:SS.sss", input: dynamocity.NewTimeOfDay(9, 30, 0, 0), expected: "09:30:00.000"},
+		{name: "Given nanoseconds, then truncate to milliseconds", input: dynamocity.NewTimeOfDay(23, 59, 59, 999999999), expected: "23:59:59.999"},
+		{name: "Given a zero time of day, then marshal midnight", input: dynamocity.TimeOfDay(0), expected: "00:00:00.000"},
+		{name: "Given a time after midnight, then wrap around", input: dynamocity.NewTimeOfDay(25, 0, 0, 0), expected: "01:00:00.000"},
+		{name: "Given a time before midnight, then wrap around", input: dynamocity.NewTimeOfDay(0, -1, 0, 0), expected: "23:59:00.000"},
+	}
+
+	for _, tc := range cases {
+		av, err := attributevalue.Marshal(tc.input)
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if actual := decodeAttributeValue(av, t); actual != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, actual)
+		}
+	}
+}
+
+func Test_TimeOfDayUnmarshalling(t *testing.T) {
+	cases := []struct {
+		name        string
+		input       string
+		expected    string
+		expectedErr bool
+	}{
+		{name: "Given HH:MM:SS.sss, then unmarshal", input: "09:30:00.250", expected: "09:30:00.250"},
+		{name: "Given HH:MM:SS, then unmarshal", input: "09:30:00", expected: "09:30:00.000"},
+		{name: "Given nanosecond precision, then unmarshal", input: "09:30:00.123456789", expected: "09:30:00.123"},
+		{name: "Given an RFC3339 Timestamp, then unmarshal the wall clock", input: "2020-04-01T09:30:00+10:00", expected: "09:30:00.000"},
+		{name: "Given hour 24, then error", input: "24:00:00.000", expectedErr: true},
+		{name: "Given a date, then error", input: "2020-04-01", expectedErr: true},
+	}
+
+	for _, tc := range cases {
+		var timeOfDay dynamocity.TimeOfDay
+		err := attributevalue.Unmarshal(&types.AttributeValueMemberS{Value: tc.input}, &timeOfDay)
+		if tc.expectedErr {
+			if err == nil {
+				t.Errorf("%s. Expected an error", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if timeOfDay.String() != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, timeOfDay)
+		}
+	}
+}
+
+func Test_TimeOfDayJSONRoundTrip(t *testing.T) {
+	type TestType struct {
+		Opens  dynamocity.TimeOfDay     `json:"opens"`
+		Closes dynamocity.NullTimeOfDay `json:"closes"`
+	}
+
+	testCase := TestType{Opens: dynamocity.NewTimeOfDay(9, 0, 0, 0)}
+
+	jsonBytes, err := json.Marshal(testCase)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	expected := `{"opens":"09:00:00.000","closes":null}`
+	if string(jsonBytes) != expected {
+		t.Errorf("Unexpected marshalled JSON. Expected '%s', Got '%s'", expected, string(jsonBytes))
+	}
+
+	var fromJSON TestType
+	if err := json.Unmarshal(jsonBytes, &fromJSON); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if fromJSON.Opens != testCase.Opens || fromJSON.Closes.Valid {
+		t.Errorf("Unexpected unmarshalled JSON. Expected '%v', Got '%v'", testCase, fromJSON)
+	}
+}
+
+func Test_TimeOfDayOn(t *testing.T) {
+	sydney, err := time.LoadLocation("Australia/Sydney")
+	if err != nil {
+		t.Skipf("Time zone database is unavailable: %v", err)
+	}
+
+	cases := []struct {
+		name     string
+		date     dynamocity.Date
+		loc      *time.Location
+		expected string
+	}{
+		{
+			name:     "Given a UTC location, then combine the date and time of day",
+			date:     dynamocity.Date(time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC)),
+			loc:      time.UTC,
+			expected: "2020-04-01T09:30:00.000Z",
+		},
+		{
+			name:     "Given a location with daylight saving, then use the offset on that date",
+			date:     dynamocity.Date(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			loc:      sydney,
+			expected: "2019-12-31T22:30:00.000Z",
+		},
+		{
+			name:     "Given a location without daylight saving on that date, then use the standard offset",
+			date:     dynamocity.Date(time.Date(2020, time.July, 1, 0, 0, 0, 0, time.UTC)),
+			loc:      sydney,
+			expected: "2020-06-30T23:30:00.000Z",
+		},
+	}
+
+	timeOfDay := dynamocity.NewTimeOfDay(9, 30, 0, 0)
+	for _, tc := range cases {
+		instant := dynamocity.MillisTime(timeOfDay.On(tc.date, tc.loc))
+		if instant.String() != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, instant)
+		}
+		if dynamocity.TimeOfDayOf(instant.Time().In(tc.loc)) != timeOfDay {
+			t.Errorf("%s. Expected the wall clock to round trip. Got '%s'", tc.name, dynamocity.TimeOfDayOf(instant.Time().In(tc.loc)))
+		}
+	}
+}
+
+func Test_TimeOfDaySortable(t *testing.T) {
+	times := []dynamocity.TimeOfDay{
+		dynamocity.NewTimeOfDay(17, 0, 0, 0),
+		dynamocity.NewTimeOfDay(9, 30, 0, 0),
+		dynamocity.NewTimeOfDay(0, 0, 0, 1000000),
+		dynamocity.NewTimeOfDay(9, 5, 0, 0),
+	}
+
+	keys := make([]string, len(times))
+	for i, timeOfDay := range times {
+		keys[i] = timeOfDay.String()
+	}
+	sort.Strings(keys)
+
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+	for i, timeOfDay := range times {
+		if keys[i] != timeOfDay.String() {
+			t.Errorf("Expected string order to match chronological order. Expected '%s', Got '%s'", timeOfDay, keys[i])
+		}
+	}
+}
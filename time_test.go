@@ -2,7 +2,9 @@ package dynamocity_test
 
 import (
 	"context"
+	"encoding"
 	"encoding/json"
+	"flag"
 	"testing"
 	"time"
 
@@ -179,6 +181,69 @@ func Test_DynamocityTime(t *testing.T) {
 				}
 			},
 		},
+		{
+			Name:       "Given a dynamocity.MicrosTime Timestamp, then verify the string attribute value has retained microseconds",
+			Timestamp:  "2018-12-31T00:00:00Z",
+			SortKey:    "microsTime",
+			IndexName:  "micros-time-index",
+			KeyBuilder: testutils.MicrosTimeKeyBuilder,
+			Verify: func(allItems []map[string]types.AttributeValue, tc testutils.SortKeyTestCase, t *testing.T) {
+
+				expectedItemTimestampsInStringOrder := []string{
+					"2019-12-09T06:50:02.000000Z",
+					"2019-12-09T06:50:02.500000Z",
+					"2019-12-09T06:50:02.530000Z",
+					"2019-12-09T06:50:02.533000Z",
+					"2019-12-09T06:50:02.533200Z",
+					"2019-12-09T06:50:02.533230Z",
+					"2019-12-09T06:50:02.533237Z",
+					"2019-12-09T06:50:02.533237Z",
+					"2019-12-09T06:50:02.533237Z",
+					"2019-12-09T06:50:02.533237Z",
+				}
+
+				if len(allItems) != 10 {
+					t.Errorf("Unexpected number of items. Expected '%d', Got '%d'", 10, len(allItems))
+				}
+
+				for i, actual := range allItems {
+					avString := decodeAttributeValue(actual[tc.SortKey], t)
+					expectedItem := expectedItemTimestampsInStringOrder[i]
+					if avString != expectedItem {
+						t.Errorf("Unexpected string attribute value %d. Expected '%s', Got '%s'", i, avString, expectedItem)
+					}
+				}
+			},
+		},
+		{
+			Name:       "Given a Timestamp, when using a sort key with dynamocity.MicrosTime, then apply sort key greaterThanEqual based on microsecond precision",
+			Timestamp:  "2019-12-09T06:50:02.533237Z",
+			SortKey:    "microsTime",
+			IndexName:  "micros-time-index",
+			KeyBuilder: testutils.MicrosTimeKeyBuilder,
+			Verify: func(allItems []map[string]types.AttributeValue, tc testutils.SortKeyTestCase, t *testing.T) {
+
+				expectedItems := map[string]string{
+					"883dc7f6-384b-4d17-8bcf-4bf1a310d582": "2019-12-09T06:50:02.533237Z",
+					"9e8a5d44-8a14-4594-b677-85f8e9f22670": "2019-12-09T06:50:02.533237Z",
+					"2e53bcda-9451-4da3-a1b4-afd165479766": "2019-12-09T06:50:02.533237Z",
+					"7721ad03-bcca-4e4c-91dc-97c30d0e85ee": "2019-12-09T06:50:02.533237Z",
+				}
+
+				if len(allItems) != len(expectedItems) {
+					t.Errorf("Unexpected number of items. Expected '%d', Got '%d'", len(expectedItems), len(allItems))
+				}
+
+				for i, actual := range allItems {
+					itemID := decodeAttributeValue(actual["sk"], t)
+					microsTimestampString := decodeAttributeValue(actual[tc.SortKey], t)
+					expectedItemTimestamp := expectedItems[itemID]
+					if microsTimestampString != expectedItemTimestamp {
+						t.Errorf("Unexpected string attribute value %d. Expected '%s', Got '%s'", i, microsTimestampString, expectedItemTimestamp)
+					}
+				}
+			},
+		},
 		{
 			Name:       "Given a dynamocity.MillisTime Timestamp, then verify the string attribute value has retained millseconds",
 			Timestamp:  "2018-12-31T00:00:00Z",
@@ -403,6 +468,7 @@ func Test_FlexibleNanoFmtUnMarshalling(t *testing.T) {
 func Test_JSONRoundTrip(t *testing.T) {
 	type TestType struct {
 		MillisTime  dynamocity.MillisTime  `json:"millisTime,omitempty"`
+		MicrosTime  dynamocity.MicrosTime  `json:"microsTime,omitempty"`
 		NanoTime    dynamocity.NanoTime    `json:"nanoTime,omitempty"`
 		SecondsTime dynamocity.SecondsTime `json:"secondsTime,omitempty"`
 	}
@@ -413,9 +479,10 @@ func Test_JSONRoundTrip(t *testing.T) {
 	}{
 		{
 			name:                   "Given expected times, then marshal and unmarshal JSON correctly",
-			expectedMarshaledBytes: []byte(`{"millisTime":"2020-01-01T14:00:00.100Z","nanoTime":"2020-01-01T14:00:00.999000000Z","secondsTime":"2020-01-01T14:00:00Z"}`),
+			expectedMarshaledBytes: []byte(`{"millisTime":"2020-01-01T14:00:00.100Z","microsTime":"2020-01-01T14:00:00.123456Z","nanoTime":"2020-01-01T14:00:00.999000000Z","secondsTime":"2020-01-01T14:00:00Z"}`),
 			testCase: TestType{
 				MillisTime:  dynamocity.MillisTime(time.Date(2020, time.January, 1, 14, 0, 0, 100000000, time.UTC)),
+				MicrosTime:  dynamocity.MicrosTime(time.Date(2020, time.January, 1, 14, 0, 0, 123456000, time.UTC)),
 				NanoTime:    dynamocity.NanoTime(time.Date(2020, time.January, 1, 14, 0, 0, 999000000, time.UTC)),
 				SecondsTime: dynamocity.SecondsTime(time.Date(2020, time.January, 1, 14, 0, 0, 0, time.UTC)),
 			},
@@ -444,6 +511,9 @@ func Test_JSONRoundTrip(t *testing.T) {
 		if !unmarshalled.MillisTime.Time().Equal(tc.testCase.MillisTime.Time()) {
 			t.Errorf("Unexpected unmarshalled Millis time. Got '%v', want '%v'", unmarshalled.MillisTime, unmarshalled.MillisTime)
 		}
+		if !unmarshalled.MicrosTime.Time().Equal(tc.testCase.MicrosTime.Time()) {
+			t.Errorf("Unexpected unmarshalled Micros time. Got '%v', want '%v'", unmarshalled.MicrosTime, tc.testCase.MicrosTime)
+		}
 		if !unmarshalled.NanoTime.Time().Equal(tc.testCase.NanoTime.Time()) {
 			t.Errorf("Unexpected unmarshalled time. Got '%v', want '%v'", unmarshalled.NanoTime, unmarshalled.NanoTime)
 		}
@@ -453,6 +523,200 @@ func Test_JSONRoundTrip(t *testing.T) {
 	}
 }
 
+func Test_MarshalNormalisesToUTC(t *testing.T) {
+	brisbane := time.FixedZone("AEST", 10*60*60)
+	newYork := time.FixedZone("EDT", -4*60*60)
+
+	cases := []struct {
+		name     string
+		actual   interface{ String() string }
+		expected string
+	}{
+		{
+			name:     "Given a dynamocity.MillisTime with a positive offset, then marshal in UTC",
+			actual:   dynamocity.MillisTime(time.Date(2020, time.April, 1, 14, 0, 0, 0, brisbane)),
+			expected: "2020-04-01T04:00:00.000Z",
+		},
+		{
+			name:     "Given a dynamocity.NanoTime with a negative offset, then marshal in UTC",
+			actual:   dynamocity.NanoTime(time.Date(2020, time.March, 31, 23, 0, 0, 1, newYork)),
+			expected: "2020-04-01T03:00:00.000000001Z",
+		},
+		{
+			name:     "Given a dynamocity.SecondsTime with a positive offset, then marshal in UTC",
+			actual:   dynamocity.SecondsTime(time.Date(2020, time.April, 1, 9, 0, 0, 0, brisbane)),
+			expected: "2020-03-31T23:00:00Z",
+		},
+	}
+
+	for _, tc := range cases {
+		if tc.actual.String() != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, tc.actual.String())
+		}
+	}
+}
+
+func Test_MarshalMixedOffsetsSortChronologically(t *testing.T) {
+	brisbane := time.FixedZone("AEST", 10*60*60)
+	chronological := []dynamocity.MillisTime{
+		dynamocity.MillisTime(time.Date(2020, time.April, 1, 3, 0, 0, 0, time.UTC)),
+		dynamocity.MillisTime(time.Date(2020, time.April, 1, 13, 30, 0, 0, brisbane)),
+		dynamocity.MillisTime(time.Date(2020, time.April, 1, 4, 0, 0, 0, time.UTC)),
+		dynamocity.MillisTime(time.Date(2020, time.April, 1, 14, 30, 0, 0, brisbane)),
+	}
+
+	for i := 1; i < len(chronological); i++ {
+		previous, current := chronological[i-1].String(), chronological[i].String()
+		if previous >= current {
+			t.Errorf("Expected '%s' to sort before '%s'", previous, current)
+		}
+	}
+}
+
+func Test_MarshalOffset(t *testing.T) {
+	brisbane := time.FixedZone("AEST", 10*60*60)
+	value := time.Date(2020, time.April, 1, 14, 0, 0, 0, brisbane)
+
+	actual := dynamocity.OffsetMillisTime(value).String()
+	expected := "2020-04-01T14:00:00.000+10:00"
+	if actual != expected {
+		t.Errorf("Unexpected marshalled time when preserving location. Expected '%s', Got '%s'", expected, actual)
+	}
+
+	av, err := dynamocity.OffsetNanoTime(value).MarshalDynamoDBAttributeValue()
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if actual, expected := av.(*types.AttributeValueMemberS).Value, "2020-04-01T14:00:00.000000000+10:00"; actual != expected {
+		t.Errorf("Unexpected marshalled attribute value when preserving location. Expected '%s', Got '%s'", expected, actual)
+	}
+
+	if actual, expected := dynamocity.MillisTime(value).String(), "2020-04-01T04:00:00.000Z"; actual != expected {
+		t.Errorf("Expected a dynamocity.MillisTime to be normalised to UTC. Expected '%s', Got '%s'", expected, actual)
+	}
+}
+
+func Test_TextRoundTrip(t *testing.T) {
+	timestamp := time.Date(2020, time.April, 1, 14, 0, 0, 123456789, time.UTC)
+	millisTime := dynamocity.MillisTime(timestamp)
+	epochSeconds := dynamocity.EpochSeconds(timestamp)
+	date := dynamocity.Date(timestamp)
+	reverseTime := dynamocity.ReverseSecondsTime(timestamp)
+
+	cases := []struct {
+		name         string
+		marshaler    encoding.TextMarshaler
+		unmarshaler  encoding.TextUnmarshaler
+		expectedText string
+	}{
+		{
+			name:         "Given a dynamocity.MillisTime, then round trip text with millisecond precision",
+			marshaler:    millisTime,
+			unmarshaler:  new(dynamocity.MillisTime),
+			expectedText: "2020-04-01T14:00:00.123Z",
+		},
+		{
+			name:         "Given a dynamocity.EpochSeconds, then round trip text as seconds",
+			marshaler:    epochSeconds,
+			unmarshaler:  new(dynamocity.EpochSeconds),
+			expectedText: "1585749600",
+		},
+		{
+			name:         "Given a dynamocity.Date, then round trip text as a date",
+			marshaler:    date,
+			unmarshaler:  new(dynamocity.Date),
+			expectedText: "2020-04-01",
+		},
+		{
+			name:         "Given a dynamocity.ReverseSecondsTime, then round trip text as a reversed Timestamp",
+			marshaler:    reverseTime,
+			unmarshaler:  new(dynamocity.ReverseSecondsTime),
+			expectedText: "7979-95-98T85:99:99Z",
+		},
+	}
+
+	for _, tc := range cases {
+		text, err := tc.marshaler.MarshalText()
+		if err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+		if string(text) != tc.expectedText {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expectedText, string(text))
+		}
+		if err := tc.unmarshaler.UnmarshalText(text); err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+		}
+		actual, err := tc.unmarshaler.(encoding.TextMarshaler).MarshalText()
+		if err != nil || string(actual) != tc.expectedText {
+			t.Errorf("%s. Unexpected round trip. Expected '%s', Got '%s'", tc.name, tc.expectedText, string(actual))
+		}
+	}
+}
+
+func Test_TextStrictUnmarshalling(t *testing.T) {
+	var strict dynamocity.StrictMillisTime
+	if err := strict.UnmarshalText([]byte("2020-04-01T14:00:00.1Z")); err == nil {
+		t.Errorf("Expected an error when unmarshalling text which does not strictly match StrictMillisFmt")
+	}
+	var flexible dynamocity.MillisTime
+	if err := flexible.UnmarshalText([]byte("2020-04-01T14:00:00.1Z")); err != nil {
+		t.Errorf("Unexpected error when unmarshalling flexible text '%v'", err)
+	}
+}
+
+func Test_TextJSONMapKeys(t *testing.T) {
+	counts := map[dynamocity.SecondsTime]int{
+		dynamocity.SecondsTime(time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC)): 1,
+		dynamocity.SecondsTime(time.Date(2020, time.April, 1, 15, 0, 0, 0, time.UTC)): 2,
+	}
+
+	actualBytes, err := json.Marshal(counts)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	expected := `{"2020-04-01T14:00:00Z":1,"2020-04-01T15:00:00Z":2}`
+	if string(actualBytes) != expected {
+		t.Errorf("Unexpected JSON map. Expected '%s', Got '%s'", expected, string(actualBytes))
+	}
+
+	var unmarshalled map[dynamocity.SecondsTime]int
+	if err := json.Unmarshal(actualBytes, &unmarshalled); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if len(unmarshalled) != len(counts) {
+		t.Errorf("Unexpected number of unmarshalled keys. Expected '%d', Got '%d'", len(counts), len(unmarshalled))
+	}
+	for key, count := range counts {
+		if unmarshalled[key] != count {
+			t.Errorf("Unexpected count for key '%s'. Expected '%d', Got '%d'", key, count, unmarshalled[key])
+		}
+	}
+}
+
+func Test_TextFlagVar(t *testing.T) {
+	var since dynamocity.MillisTime
+	var on dynamocity.Date
+
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.TextVar(&since, "since", dynamocity.MillisTime{}, "since timestamp")
+	flags.TextVar(&on, "on", dynamocity.Date{}, "on date")
+
+	if err := flags.Parse([]string{"-since", "2020-04-01T14:00:00.5Z", "-on", "2020-04-02"}); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if since.String() != "2020-04-01T14:00:00.500Z" {
+		t.Errorf("Unexpected since flag value. Got '%s'", since)
+	}
+	if on.String() != "2020-04-02" {
+		t.Errorf("Unexpected on flag value. Got '%s'", on)
+	}
+}
+
 func Test_BetweenStartInc(t *testing.T) {
 	cases := []struct {
 		name       string
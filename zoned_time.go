@@ -0,0 +1,220 @@
+package dynamocity
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// ZonedTime represents a Timestamp with the fixed precision supplied by P, which remembers its original time.Location
+// while still sorting by instant.
+//
+// ZonedTime is marshalled as the UTC instant with the layout of P, followed by the IANA time zone name in square
+// brackets, as per RFC 9557. For example: `2020-04-01T04:00:00.000Z[Australia/Sydney]`. As the instant is first and
+// fixed width, the marshalled string sorts chronologically regardless of the zone. A time.Location which cannot be
+// loaded by name, such as a time.FixedZone or time.Local, is marshalled as its offset, for example `2020-04-01T04:00:00.000Z[+10:00]`.
+//
+// ZonedTime restores the original time.Location when unmarshalled, and can also unmarshal any RFC3339 Timestamp, in
+// which case the location is the offset of the Timestamp.
+type ZonedTime[P Precision] time.Time
+
+// ZonedNanoTime is a dynamocity.ZonedTime with fixed nanosecond precision
+type ZonedNanoTime = ZonedTime[NanoPrecision]
+
+// ZonedMicrosTime is a dynamocity.ZonedTime with fixed microsecond precision
+type ZonedMicrosTime = ZonedTime[MicrosPrecision]
+
+// ZonedMillisTime is a dynamocity.ZonedTime with fixed millisecond precision
+type ZonedMillisTime = ZonedTime[MillisPrecision]
+
+// ZonedSecondsTime is a dynamocity.ZonedTime with fixed second precision
+type ZonedSecondsTime = ZonedTime[SecondsPrecision]
+
+// locations caches each successfully loaded time.Location by name, as time.LoadLocation reads the zone database
+var locations sync.Map
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface to marshal
+// a dynamocity.ZonedTime into a DynamoDB AttributeValue string value.
+func (t ZonedTime[P]) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return t.attributeValue(), nil
+}
+
+// attributeValue is a helper function to marshal a dynamocity.ZonedTime into a string AttributeValue
+func (t ZonedTime[P]) attributeValue() types.AttributeValue {
+	return &types.AttributeValueMemberS{
+		Value: t.String(),
+	}
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface to unmarshal
+// a types.AttributeValue into a dynamocity.ZonedTime. A NULL AttributeValue is unmarshalled as a zero dynamocity.ZonedTime
+func (t *ZonedTime[P]) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if isNull(av) {
+		*t = ZonedTime[P]{}
+		return nil
+	}
+	tv, ok := av.(*types.AttributeValueMemberS)
+	if !ok {
+		return &attributevalue.UnmarshalTypeError{
+			Value: fmt.Sprintf("%T", av),
+			Type:  reflect.TypeOf((*ZonedTime[P])(nil)),
+		}
+	}
+	parsed, err := ParseZonedTime[P](tv.Value)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// Time is a handler func to return an instance of dynamocity.ZonedTime as time.Time in its original time.Location
+func (t ZonedTime[P]) Time() time.Time {
+	return time.Time(t)
+}
+
+// Location returns the original time.Location of this dynamocity.ZonedTime
+func (t ZonedTime[P]) Location() *time.Location {
+	return t.Time().Location()
+}
+
+// Instant returns this dynamocity.ZonedTime as a dynamocity.Time of the same precision
+func (t ZonedTime[P]) Instant() Time[P] {
+	return Time[P](t)
+}
+
+// In returns this dynamocity.Time as a dynamocity.ZonedTime of the same precision in the supplied time.Location
+func (t Time[P]) In(loc *time.Location) ZonedTime[P] {
+	return ZonedTime[P](t.Time().In(loc))
+}
+
+// IsZero reports whether this dynamocity.ZonedTime represents the zero time instant
+func (t ZonedTime[P]) IsZero() bool {
+	return t.Time().IsZero()
+}
+
+// Compare compares this dynamocity.ZonedTime with u in the order of their marshalled strings, which is by instant at
+// the precision of P, and then by zone
+func (t ZonedTime[P]) Compare(u ZonedTime[P]) int {
+	if c := t.Instant().Compare(u.Instant()); c != 0 {
+		return c
+	}
+	return strings.Compare(zoneName(t.Time()), zoneName(u.Time()))
+}
+
+// String implements the fmt.Stringer interface to supply the UTC instant with the layout of P, followed by the zone
+func (t ZonedTime[P]) String() string {
+	return t.Time().UTC().Format(layout[P]()) + "[" + zoneName(t.Time()) + "]"
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to unmarshal a zoned or RFC3339 timestamp. A JSON null is a no-op
+func (t *ZonedTime[P]) UnmarshalJSON(b []byte) error {
+	if isJSONNull(b) {
+		return nil
+	}
+	str, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	parsed, err := ParseZonedTime[P](str)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface to marshal the UTC instant with the layout of P, followed by the zone
+func (t ZonedTime[P]) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(t.String())), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface to unmarshal a zoned or RFC3339 timestamp
+func (t *ZonedTime[P]) UnmarshalText(b []byte) error {
+	parsed, err := ParseZonedTime[P](string(b))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface to marshal the UTC instant with the layout of P, followed by the zone
+func (t ZonedTime[P]) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// ParseZonedTime will attempt to parse a Timestamp followed by an IANA time zone name or offset in square brackets, or
+// any RFC3339 Timestamp, to a dynamocity.ZonedTime. The Timestamp is parsed according to the Precision P
+func ParseZonedTime[P Precision](str string) (ZonedTime[P], error) {
+	timestamp, zone, hasZone := strings.Cut(str, "[")
+	if hasZone && (!strings.HasSuffix(zone, "]") || len(zone) < 2) {
+		return ZonedTime[P]{}, fmt.Errorf("Zoned Timestamp '%s' has an invalid zone", str)
+	}
+	parsedTime, err := parseTime[P](timestamp)
+	if err != nil {
+		return ZonedTime[P]{}, err
+	}
+	if !hasZone {
+		return ZonedTime[P](parsedTime), nil
+	}
+	loc, err := parseZone(strings.TrimSuffix(zone, "]"))
+	if err != nil {
+		return ZonedTime[P]{}, fmt.Errorf("Zoned Timestamp '%s' has an invalid zone: %w", str, err)
+	}
+	return ZonedTime[P](parsedTime.In(loc)), nil
+}
+
+// zoneName is a helper function to return the IANA time zone name of the time.Location of a time.Time if it can be
+// loaded by name, and the loaded time.Location has the same offset at that instant; otherwise the offset of the
+// time.Time. time.Local is always an offset, as its name is not portable, and a time.FixedZone which reuses a zone
+// name is an offset unless it agrees with that zone
+func zoneName(t time.Time) string {
+	offset := t.Format("-07:00")
+	name := t.Location().String()
+	if name == "" || name == "Local" {
+		return offset
+	}
+	loc, err := loadLocation(name)
+	if err != nil {
+		return offset
+	}
+	_, actualOffset := t.Zone()
+	if _, loadedOffset := t.In(loc).Zone(); loadedOffset != actualOffset {
+		return offset
+	}
+	return name
+}
+
+// parseZone is a helper function to parse an IANA time zone name or an offset to a time.Location
+func parseZone(zone string) (*time.Location, error) {
+	if strings.HasPrefix(zone, "+") || strings.HasPrefix(zone, "-") {
+		offset, err := time.Parse("-07:00", zone)
+		if err != nil {
+			return nil, err
+		}
+		_, seconds := offset.Zone()
+		return time.FixedZone(zone, seconds), nil
+	}
+	return loadLocation(zone)
+}
+
+// loadLocation is a helper function to load a time.Location by name, caching only a successful load. A failure is not
+// cached because the name may come from untrusted input, and caching every invalid name would grow without bound
+func loadLocation(name string) (*time.Location, error) {
+	if cached, ok := locations.Load(name); ok {
+		return cached.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
@@ -0,0 +1,203 @@
+package dynamocity_test
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/edwardsmatt/dynamocity"
+)
+
+// loadLocation is a helper function to load a time.Location, skipping the test if the zone database is unavailable
+func loadLocation(name string, t *testing.T) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("Time zone database is unavailable: %v", err)
+	}
+	return loc
+}
+
+func Test_ZonedTimeRoundTrip(t *testing.T) {
+	sydney := loadLocation("Australia/Sydney", t)
+
+	type TestType struct {
+		StartsAt dynamocity.ZonedMillisTime `dynamodbav:"startsAt" json:"startsAt"`
+	}
+
+	cases := []struct {
+		name     string
+		input    time.Time
+		expected string
+	}{
+		{
+			name:     "Given an IANA zone, then marshal the UTC instant and the zone name",
+			input:    time.Date(2020, time.April, 1, 15, 0, 0, 0, sydney),
+			expected: "2020-04-01T04:00:00.000Z[Australia/Sydney]",
+		},
+		{
+			name:     "Given UTC, then marshal the UTC zone name",
+			input:    time.Date(2020, time.April, 1, 4, 0, 0, 0, time.UTC),
+			expected: "2020-04-01T04:00:00.000Z[UTC]",
+		},
+		{
+			name:     "Given a fixed zone, then marshal the offset",
+			input:    time.Date(2020, time.April, 1, 14, 0, 0, 0, time.FixedZone("AEST", 10*60*60)),
+			expected: "2020-04-01T04:00:00.000Z[+10:00]",
+		},
+	}
+
+	for _, tc := range cases {
+		testCase := TestType{StartsAt: dynamocity.ZonedMillisTime(tc.input)}
+
+		item, err := attributevalue.MarshalMap(testCase)
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if actual := decodeAttributeValue(item["startsAt"], t); actual != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, actual)
+		}
+
+		var fromDynamo TestType
+		if err := attributevalue.UnmarshalMap(item, &fromDynamo); err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if !fromDynamo.StartsAt.Time().Equal(tc.input) || fromDynamo.StartsAt.Time().Format(time.RFC3339) != tc.input.Format(time.RFC3339) {
+			t.Errorf("%s. Expected the wall clock '%s' after attribute value round trip, Got '%s'", tc.name, tc.input.Format(time.RFC3339), fromDynamo.StartsAt.Time().Format(time.RFC3339))
+		}
+
+		jsonBytes, err := json.Marshal(testCase)
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		var fromJSON TestType
+		if err := json.Unmarshal(jsonBytes, &fromJSON); err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if fromJSON.StartsAt.String() != tc.expected {
+			t.Errorf("%s. Expected '%s' after JSON round trip, Got '%s'", tc.name, tc.expected, fromJSON.StartsAt)
+		}
+	}
+
+	var restored dynamocity.ZonedMillisTime
+	if err := restored.UnmarshalText([]byte("2020-07-01T04:00:00.000Z[Australia/Sydney]")); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	if restored.Location().String() != "Australia/Sydney" || restored.Time().Hour() != 14 {
+		t.Errorf("Expected the Australia/Sydney location to be restored with its standard offset. Got '%v'", restored.Time())
+	}
+}
+
+func Test_ZonedTimeSortsByInstant(t *testing.T) {
+	sydney := loadLocation("Australia/Sydney", t)
+	newYork := loadLocation("America/New_York", t)
+
+	instants := []dynamocity.ZonedMillisTime{
+		dynamocity.ZonedMillisTime(time.Date(2020, time.April, 1, 10, 0, 0, 0, newYork)),
+		dynamocity.ZonedMillisTime(time.Date(2020, time.April, 2, 9, 0, 0, 0, sydney)),
+		dynamocity.ZonedMillisTime(time.Date(2020, time.April, 1, 14, 0, 0, 0, time.UTC)),
+		dynamocity.ZonedMillisTime(time.Date(2020, time.April, 1, 23, 0, 0, 0, sydney)),
+	}
+
+	keys := make([]string, len(instants))
+	for i, instant := range instants {
+		keys[i] = instant.String()
+	}
+	sort.Strings(keys)
+
+	sort.Slice(instants, func(i, j int) bool { return instants[i].Compare(instants[j]) < 0 })
+	for i, instant := range instants {
+		if keys[i] != instant.String() {
+			t.Errorf("Expected string order to match chronological order. Expected '%s', Got '%s'", instant, keys[i])
+		}
+	}
+	for i := 1; i < len(instants); i++ {
+		if instants[i].Time().Before(instants[i-1].Time()) {
+			t.Errorf("Expected chronological order. Got '%s' before '%s'", instants[i-1], instants[i])
+		}
+	}
+}
+
+func Test_ZonedTimeParsing(t *testing.T) {
+	cases := []struct {
+		name           string
+		input          string
+		expected       string
+		expectedOffset int
+		expectedErr    bool
+	}{
+		{name: "Given an RFC3339 Timestamp, then use its offset", input: "2020-04-01T14:00:00+10:00", expected: "2020-04-01T04:00:00.000Z[+10:00]", expectedOffset: 10 * 60 * 60},
+		{name: "Given a negative offset zone, then restore the offset", input: "2020-04-01T04:00:00.000Z[-05:30]", expected: "2020-04-01T04:00:00.000Z[-05:30]", expectedOffset: -(5*60 + 30) * 60},
+		{name: "Given an unknown zone, then error", input: "2020-04-01T04:00:00.000Z[Nowhere/Special]", expectedErr: true},
+		{name: "Given an unterminated zone, then error", input: "2020-04-01T04:00:00.000Z[UTC", expectedErr: true},
+		{name: "Given an empty zone, then error", input: "2020-04-01T04:00:00.000Z[]", expectedErr: true},
+	}
+
+	for _, tc := range cases {
+		zoned, err := dynamocity.ParseZonedTime[dynamocity.MillisPrecision](tc.input)
+		if tc.expectedErr {
+			if err == nil {
+				t.Errorf("%s. Expected an error, Got '%s'", tc.name, zoned)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s. Unexpected error '%v'", tc.name, err)
+			continue
+		}
+		if zoned.String() != tc.expected {
+			t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, zoned)
+		}
+		if _, offset := zoned.Time().Zone(); offset != tc.expectedOffset {
+			t.Errorf("%s. Expected offset '%d', Got '%d'", tc.name, tc.expectedOffset, offset)
+		}
+	}
+}
+
+func Test_ZonedTimeFixedZoneName(t *testing.T) {
+	instant := time.Date(2020, time.April, 1, 4, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name     string
+		loc      *time.Location
+		expected string
+	}{
+		{name: "Given a fixed zone named UTC with an offset, then marshal the offset", loc: time.FixedZone("UTC", 10*60*60), expected: "2020-04-01T04:00:00.000Z[+10:00]"},
+		{name: "Given a fixed zone named for a zone with a different offset, then marshal the offset", loc: time.FixedZone("Australia/Sydney", 10*60*60), expected: "2020-04-01T04:00:00.000Z[+10:00]"},
+		{name: "Given a fixed zone named for a zone with the same offset, then marshal the zone name", loc: time.FixedZone("Australia/Sydney", 11*60*60), expected: "2020-04-01T04:00:00.000Z[Australia/Sydney]"},
+		{name: "Given a fixed zone which does not name a zone, then marshal the offset", loc: time.FixedZone("AEST", 10*60*60), expected: "2020-04-01T04:00:00.000Z[+10:00]"},
+	}
+
+	for _, tc := range cases {
+		// marshal twice, so that the second uses the cached zone
+		for i := 0; i < 2; i++ {
+			if actual := dynamocity.ZonedMillisTime(instant.In(tc.loc)).String(); actual != tc.expected {
+				t.Errorf("%s. Expected '%s', Got '%s'", tc.name, tc.expected, actual)
+			}
+		}
+	}
+}
+
+func Test_ZonedTimeLocationCache(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		zone     string
+		expected bool
+	}{
+		{name: "Given a known zone, then cache the location", input: "2020-04-01T04:00:00.000Z[Australia/Brisbane]", zone: "Australia/Brisbane", expected: true},
+		{name: "Given an unknown zone, then do not cache the failure", input: "2020-04-01T04:00:00.000Z[Nowhere/Uncached]", zone: "Nowhere/Uncached", expected: false},
+	}
+
+	for _, tc := range cases {
+		_, _ = dynamocity.ParseZonedTime[dynamocity.MillisPrecision](tc.input)
+		if cached := dynamocity.IsLocationCached(tc.zone); cached != tc.expected {
+			t.Errorf("%s. Expected cached '%t', Got '%t'", tc.name, tc.expected, cached)
+		}
+	}
+}